@@ -2,11 +2,41 @@ package solus
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 )
 
 // AccountService handles all available methods with a current user account.
 type AccountService service
 
+// AccountUpdateRequest represents available properties for updating current
+// user account. CurrentPassword is required for changing password.
+type AccountUpdateRequest struct {
+	Email           string `json:"email,omitempty"`
+	Password        string `json:"password,omitempty"`
+	CurrentPassword string `json:"current_password,omitempty"`
+	LanguageID      int    `json:"language_id,omitempty"`
+}
+
+// AccountLimits represents limits of current user account and their usage.
+type AccountLimits struct {
+	LimitGroup LimitGroup
+	Usage      LimitGroupUsage
+}
+
+// AccountTwoFactorAuthSecret represents a secret for enabling two-factor
+// authentication. URL is an otpauth:// URL which can be rendered as QR code
+// for authenticator applications.
+type AccountTwoFactorAuthSecret struct {
+	Secret string `json:"secret"`
+	URL    string `json:"url"`
+}
+
+type accountTwoFactorAuthRequest struct {
+	Code string `json:"code"`
+}
+
 // Get retrieves current user account.
 func (s *AccountService) Get(ctx context.Context) (User, error) {
 	var resp struct {
@@ -14,3 +44,121 @@ func (s *AccountService) Get(ctx context.Context) (User, error) {
 	}
 	return resp.Data, s.client.get(ctx, "account", &resp)
 }
+
+// Update updates current user account.
+func (s *AccountService) Update(ctx context.Context, data AccountUpdateRequest) (User, error) {
+	var resp struct {
+		Data User `json:"data"`
+	}
+	return resp.Data, s.client.update(ctx, "account", data, &resp)
+}
+
+// ChangePassword changes password of current user account.
+func (s *AccountService) ChangePassword(ctx context.Context, currentPassword, newPassword string) error {
+	if currentPassword == "" || newPassword == "" {
+		return errors.New("current and new passwords are required")
+	}
+
+	_, err := s.Update(ctx, AccountUpdateRequest{
+		Password:        newPassword,
+		CurrentPassword: currentPassword,
+	})
+	return err
+}
+
+// ChangeLanguage changes interface language of current user account.
+func (s *AccountService) ChangeLanguage(ctx context.Context, languageID int) (User, error) {
+	return s.Update(ctx, AccountUpdateRequest{LanguageID: languageID})
+}
+
+// APITokens lists API tokens of current user account.
+// Unlike APITokensService it uses the account/api_tokens endpoint, so it's
+// available to users without permission to manage API tokens.
+func (s *AccountService) APITokens(ctx context.Context) (APITokensResponse, error) {
+	resp := APITokensResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, "account/api_tokens", &resp)
+}
+
+// APITokenCreate creates new API token for current user account. The token's
+// value is returned only once in APIToken.AccessToken.
+func (s *AccountService) APITokenCreate(ctx context.Context, name string) (APIToken, error) {
+	var resp struct {
+		Data APIToken `json:"data"`
+	}
+	return resp.Data, s.client.create(ctx, "account/api_tokens", APITokenCreateRequest{Name: name}, &resp)
+}
+
+// APITokenRevoke revokes specified API token of current user account.
+func (s *AccountService) APITokenRevoke(ctx context.Context, id int) error {
+	return s.client.syncDelete(ctx, fmt.Sprintf("account/api_tokens/%d", id))
+}
+
+// Limits retrieves limits of current user account and calculates their usage.
+// Limit group from settings is used if the user doesn't belong to any, the
+// same way as LimitGroupsService.CheckVirtualServerCreate does.
+func (s *AccountService) Limits(ctx context.Context) (AccountLimits, error) {
+	user, err := s.Get(ctx)
+	if err != nil {
+		return AccountLimits{}, err
+	}
+
+	lg, err := s.client.LimitGroups.userLimitGroup(ctx, user)
+	if err != nil {
+		return AccountLimits{}, err
+	}
+
+	usage, err := s.client.LimitGroups.Usage(ctx, user.ID)
+	if err != nil {
+		return AccountLimits{}, err
+	}
+
+	return AccountLimits{
+		LimitGroup: lg,
+		Usage:      usage,
+	}, nil
+}
+
+// TwoFactorAuthInit generates new secret for enabling two-factor authentication.
+// The authentication isn't enabled until TwoFactorAuthEnable is called with a
+// code generated from the secret.
+func (s *AccountService) TwoFactorAuthInit(ctx context.Context) (AccountTwoFactorAuthSecret, error) {
+	const path = "account/two_factor_auth"
+	body, code, err := s.client.request(ctx, http.MethodPost, path)
+	if err != nil {
+		return AccountTwoFactorAuthSecret{}, err
+	}
+
+	if code != http.StatusOK {
+		return AccountTwoFactorAuthSecret{}, newHTTPError(http.MethodPost, path, code, body)
+	}
+
+	var resp struct {
+		Data AccountTwoFactorAuthSecret `json:"data"`
+	}
+	return resp.Data, unmarshal(body, &resp)
+}
+
+// TwoFactorAuthEnable enables two-factor authentication for current user
+// account. The code should be generated from the secret returned by
+// TwoFactorAuthInit.
+func (s *AccountService) TwoFactorAuthEnable(ctx context.Context, code string) error {
+	return s.client.syncPost(
+		ctx,
+		"account/two_factor_auth/enable",
+		withBody(accountTwoFactorAuthRequest{Code: code}),
+	)
+}
+
+// TwoFactorAuthDisable disables two-factor authentication for current user
+// account. The code should be generated by the authenticator application.
+func (s *AccountService) TwoFactorAuthDisable(ctx context.Context, code string) error {
+	return s.client.syncPost(
+		ctx,
+		"account/two_factor_auth/disable",
+		withBody(accountTwoFactorAuthRequest{Code: code}),
+	)
+}
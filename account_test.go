@@ -22,3 +22,241 @@ func TestAccountService_Get(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, fakeUser, actual)
 }
+
+func TestAccountService_Update(t *testing.T) {
+	data := AccountUpdateRequest{
+		Email:      "new@example.com",
+		LanguageID: 1,
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusOK, fakeUser)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Account.Update(context.Background(), data)
+	require.NoError(t, err)
+	require.Equal(t, fakeUser, actual)
+}
+
+func TestAccountService_ChangePassword(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/account", r.URL.Path)
+			assert.Equal(t, http.MethodPut, r.Method)
+			assertRequestBody(t, r, AccountUpdateRequest{
+				Password:        "new",
+				CurrentPassword: "current",
+			})
+
+			writeResponse(t, w, http.StatusOK, fakeUser)
+		})
+		defer s.Close()
+
+		err := createTestClient(t, s.URL).Account.ChangePassword(context.Background(), "current", "new")
+		require.NoError(t, err)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		err := createTestClient(t, "").Account.ChangePassword(context.Background(), "", "new")
+		require.EqualError(t, err, "current and new passwords are required")
+	})
+}
+
+func TestAccountService_ChangeLanguage(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assertRequestBody(t, r, AccountUpdateRequest{LanguageID: 2})
+
+		writeResponse(t, w, http.StatusOK, fakeUser)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Account.ChangeLanguage(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, fakeUser, actual)
+}
+
+func TestAccountService_APITokens(t *testing.T) {
+	expected := APITokensResponse{
+		Data: []APIToken{
+			fakeAPIToken,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account/api_tokens", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Account.APITokens(context.Background())
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
+func TestAccountService_APITokenCreate(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account/api_tokens", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, APITokenCreateRequest{Name: "name"})
+
+		writeResponse(t, w, http.StatusCreated, fakeAPIToken)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Account.APITokenCreate(context.Background(), "name")
+	require.NoError(t, err)
+	require.Equal(t, fakeAPIToken, actual)
+}
+
+func TestAccountService_APITokenRevoke(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account/api_tokens/10", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestClient(t, s.URL).Account.APITokenRevoke(context.Background(), 10)
+	require.NoError(t, err)
+}
+
+func TestAccountService_Limits(t *testing.T) {
+	defaultLimitGroup := LimitGroup{
+		ID:             2,
+		VirtualServers: LimitGroupLimit{Limit: 2, IsEnabled: true},
+	}
+
+	handler := func(t *testing.T, user User) func(w http.ResponseWriter, r *http.Request) {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			switch r.URL.Path {
+			case "/account":
+				writeResponse(t, w, http.StatusOK, user)
+
+			case "/settings":
+				writeResponse(t, w, http.StatusOK, Settings{LimitGroup: defaultLimitGroup})
+
+			case "/servers":
+				assert.Equal(t, "10", r.URL.Query().Get("filter[user_id]"))
+				writeJSON(t, w, http.StatusOK, VirtualServersResponse{
+					Data: []VirtualServer{
+						{ID: 1, Status: VirtualServerStatusStarted},
+					},
+				})
+
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		}
+	}
+
+	cc := map[string]struct {
+		user     User
+		expected LimitGroup
+	}{
+		"user's limit group": {
+			user:     User{ID: 10, LimitGroup: fakeLimitGroup},
+			expected: fakeLimitGroup,
+		},
+		"default limit group": {
+			user:     User{ID: 10},
+			expected: defaultLimitGroup,
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			s := startTestServer(t, handler(t, c.user))
+			defer s.Close()
+
+			actual, err := createTestClient(t, s.URL).Account.Limits(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, AccountLimits{
+				LimitGroup: c.expected,
+				Usage: LimitGroupUsage{
+					VirtualServers:        1,
+					RunningVirtualServers: 1,
+				},
+			}, actual)
+		})
+	}
+}
+
+func TestAccountService_TwoFactorAuthInit(t *testing.T) {
+	expected := AccountTwoFactorAuthSecret{
+		Secret: "JBSWY3DPEHPK3PXP",
+		URL:    "otpauth://totp/SolusIO:fake@example.com?secret=JBSWY3DPEHPK3PXP",
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/account/two_factor_auth", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			writeResponse(t, w, http.StatusOK, expected)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Account.TwoFactorAuthInit(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to make request", func(t *testing.T) {
+			asserter, addr := startBrokenTestServer(t)
+			_, err := createTestClient(t, addr).Account.TwoFactorAuthInit(context.Background())
+			asserter(t, http.MethodPost, "/account/two_factor_auth", err)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Account.TwoFactorAuthInit(context.Background())
+			require.EqualError(t, err, "HTTP POST account/two_factor_auth returns 400 status code")
+		})
+	})
+}
+
+func TestAccountService_TwoFactorAuthEnable(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account/two_factor_auth/enable", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, accountTwoFactorAuthRequest{Code: "123456"})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestClient(t, s.URL).Account.TwoFactorAuthEnable(context.Background(), "123456")
+	require.NoError(t, err)
+}
+
+func TestAccountService_TwoFactorAuthDisable(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account/two_factor_auth/disable", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, accountTwoFactorAuthRequest{Code: "123456"})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestClient(t, s.URL).Account.TwoFactorAuthDisable(context.Background(), "123456")
+	require.NoError(t, err)
+}
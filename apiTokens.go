@@ -0,0 +1,125 @@
+package solus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APITokensService handles all available methods with API tokens.
+// It uses the administrative api_tokens endpoint which requires permission to
+// manage API tokens. Tokens of the current user account can be managed without
+// it by AccountService.APITokens, AccountService.APITokenCreate and
+// AccountService.APITokenRevoke, which use the account/api_tokens endpoint.
+type APITokensService service
+
+// APIToken represents an API token.
+type APIToken struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	// AccessToken a token value. It's available only right after the token
+	// creation.
+	AccessToken string `json:"access_token,omitempty"`
+	// CreatedAt for date in RFC3339Nano format
+	CreatedAt string `json:"created_at"`
+}
+
+// APITokensResponse represents paginated list of API tokens.
+// This cursor can be used for iterating over all available API tokens.
+type APITokensResponse struct {
+	paginatedResponse
+
+	Data []APIToken `json:"data"`
+}
+
+// APITokenCreateRequest represents available properties for creating a new API
+// token.
+type APITokenCreateRequest struct {
+	Name string `json:"name"`
+}
+
+type apiTokenResponse struct {
+	Data APIToken `json:"data"`
+}
+
+// List lists API tokens.
+func (s *APITokensService) List(ctx context.Context) (APITokensResponse, error) {
+	resp := APITokensResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, "api_tokens", &resp)
+}
+
+// Get gets specified API token. The token's value isn't returned.
+func (s *APITokensService) Get(ctx context.Context, id int) (APIToken, error) {
+	var resp apiTokenResponse
+	return resp.Data, s.client.get(ctx, fmt.Sprintf("api_tokens/%d", id), &resp)
+}
+
+// Create creates new API token. The token's value is returned only once in
+// APIToken.AccessToken.
+func (s *APITokensService) Create(ctx context.Context, data APITokenCreateRequest) (APIToken, error) {
+	var resp apiTokenResponse
+	return resp.Data, s.client.create(ctx, "api_tokens", data, &resp)
+}
+
+// Delete revokes specified API token.
+func (s *APITokensService) Delete(ctx context.Context, id int) error {
+	return s.client.syncDelete(ctx, fmt.Sprintf("api_tokens/%d", id))
+}
+
+// Rotate replaces the API token which the client is authenticated with by a
+// new one with specified name.
+// The new token is verified by fetching current user account with it before
+// the client starts to use it. The old token is revoked only after the client
+// is switched to the new one, so concurrent requests never use a revoked token.
+// The new token is returned even if the old one failed to be revoked, the
+// client uses the new token in that case as well.
+// Like other APITokensService methods it requires permission to manage API
+// tokens.
+func (s *APITokensService) Rotate(ctx context.Context, oldID int, name string) (APIToken, error) {
+	token, err := s.Create(ctx, APITokenCreateRequest{Name: name})
+	if err != nil {
+		return APIToken{}, fmt.Errorf("create API token: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return APIToken{}, errors.New("created API token doesn't have an access token")
+	}
+
+	credentials := Credentials{
+		AccessToken: token.AccessToken,
+		TokenType:   "Bearer",
+	}
+
+	if err := s.verify(ctx, credentials); err != nil {
+		if delErr := s.Delete(ctx, token.ID); delErr != nil {
+			s.client.Logger.Errorf("failed to revoke unverified API token %d: %s", token.ID, delErr)
+		}
+		return APIToken{}, fmt.Errorf("verify API token %d: %w", token.ID, err)
+	}
+
+	s.client.SetCredentials(credentials)
+
+	if err := s.Delete(ctx, oldID); err != nil {
+		return token, fmt.Errorf("revoke old API token %d: %w", oldID, err)
+	}
+	return token, nil
+}
+
+// verify checks the credentials are accepted by fetching current user account.
+func (s *APITokensService) verify(ctx context.Context, credentials Credentials) error {
+	const path = "account"
+	body, code, err := s.client.request(ctx, http.MethodGet, path, withCredentials(credentials))
+	if err != nil {
+		return err
+	}
+
+	if code != http.StatusOK {
+		return newHTTPError(http.MethodGet, path, code, body)
+	}
+	return nil
+}
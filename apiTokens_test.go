@@ -0,0 +1,200 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPITokensService_List(t *testing.T) {
+	expected := APITokensResponse{
+		Data: []APIToken{
+			fakeAPIToken,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api_tokens", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).APITokens.List(context.Background())
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
+func TestAPITokensService_Get(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api_tokens/10", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeAPIToken)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).APITokens.Get(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeAPIToken, actual)
+}
+
+func TestAPITokensService_Create(t *testing.T) {
+	data := APITokenCreateRequest{
+		Name: "name",
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api_tokens", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusCreated, fakeAPIToken)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).APITokens.Create(context.Background(), data)
+	require.NoError(t, err)
+	require.Equal(t, fakeAPIToken, actual)
+}
+
+func TestAPITokensService_Delete(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api_tokens/10", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestClient(t, s.URL).APITokens.Delete(context.Background(), 10)
+	require.NoError(t, err)
+}
+
+func TestAPITokensService_Rotate(t *testing.T) {
+	oldCredentials := Credentials{
+		AccessToken: "old",
+		TokenType:   "Bearer",
+	}
+
+	newToken := APIToken{
+		ID:          2,
+		Name:        "rotated",
+		AccessToken: "new",
+	}
+
+	newCredentials := Credentials{
+		AccessToken: "new",
+		TokenType:   "Bearer",
+	}
+
+	type handlerOpts struct {
+		accountCode int
+		revokeCode  int
+	}
+
+	handler := func(t *testing.T, opts handlerOpts, calls *[]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			*calls = append(*calls, r.Method+" "+r.URL.Path+" "+r.Header.Get("Authorization"))
+
+			switch {
+			case r.Method == http.MethodPost && r.URL.Path == "/api_tokens":
+				assertRequestBody(t, r, APITokenCreateRequest{Name: "rotated"})
+				writeResponse(t, w, http.StatusCreated, newToken)
+
+			case r.Method == http.MethodGet && r.URL.Path == "/account":
+				if opts.accountCode != http.StatusOK {
+					w.WriteHeader(opts.accountCode)
+					return
+				}
+				writeResponse(t, w, http.StatusOK, fakeUser)
+
+			case r.Method == http.MethodDelete && r.URL.Path == "/api_tokens/1":
+				w.WriteHeader(opts.revokeCode)
+
+			case r.Method == http.MethodDelete && r.URL.Path == "/api_tokens/2":
+				w.WriteHeader(http.StatusNoContent)
+
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		}
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		var calls []string
+		s := startTestServer(t, handler(t, handlerOpts{
+			accountCode: http.StatusOK,
+			revokeCode:  http.StatusNoContent,
+		}, &calls))
+		defer s.Close()
+
+		c := createTestClient(t, s.URL)
+		c.SetCredentials(oldCredentials)
+
+		actual, err := c.APITokens.Rotate(context.Background(), 1, "rotated")
+		require.NoError(t, err)
+		require.Equal(t, newToken, actual)
+		require.Equal(t, newCredentials, c.Credentials)
+		require.Equal(t, []string{
+			"POST /api_tokens Bearer old",
+			"GET /account Bearer new",
+			"DELETE /api_tokens/1 Bearer new",
+		}, calls)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to verify", func(t *testing.T) {
+			var calls []string
+			s := startTestServer(t, handler(t, handlerOpts{
+				accountCode: http.StatusUnauthorized,
+				revokeCode:  http.StatusNoContent,
+			}, &calls))
+			defer s.Close()
+
+			c := createTestClient(t, s.URL)
+			c.SetCredentials(oldCredentials)
+
+			_, err := c.APITokens.Rotate(context.Background(), 1, "rotated")
+			require.EqualError(t, err, "verify API token 2: HTTP GET account returns 401 status code")
+			require.Equal(t, oldCredentials, c.Credentials)
+			require.Equal(t, []string{
+				"POST /api_tokens Bearer old",
+				"GET /account Bearer new",
+				"DELETE /api_tokens/2 Bearer old",
+			}, calls)
+		})
+
+		t.Run("failed to revoke", func(t *testing.T) {
+			var calls []string
+			s := startTestServer(t, handler(t, handlerOpts{
+				accountCode: http.StatusOK,
+				revokeCode:  http.StatusBadRequest,
+			}, &calls))
+			defer s.Close()
+
+			c := createTestClient(t, s.URL)
+			c.SetCredentials(oldCredentials)
+
+			actual, err := c.APITokens.Rotate(context.Background(), 1, "rotated")
+			require.EqualError(t, err, "revoke old API token 1: HTTP DELETE api_tokens/1 returns 400 status code")
+			require.Equal(t, newToken, actual)
+			require.Equal(t, newCredentials, c.Credentials)
+		})
+
+		t.Run("no access token", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(t, w, http.StatusCreated, fakeAPIToken)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).APITokens.Rotate(context.Background(), 1, "rotated")
+			require.EqualError(t, err, "created API token doesn't have an access token")
+		})
+	})
+}
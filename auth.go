@@ -0,0 +1,73 @@
+package solus
+
+import (
+	"context"
+	"net/url"
+)
+
+// AuthService handles public authentication methods which don't require
+// credentials, like registration and password recovery.
+type AuthService service
+
+// AuthRegisterRequest represents available properties for registering a new
+// user. Registration should be allowed by SettingsFeatures.AllowRegistration.
+type AuthRegisterRequest struct {
+	Email      string `json:"email"`
+	Password   string `json:"password"`
+	LanguageID int    `json:"language_id,omitempty"`
+}
+
+// AuthResetPasswordRequest represents available properties for completing
+// password reset. Token is taken from the email sent by RequestPasswordReset.
+type AuthResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type authVerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+type authRequestPasswordResetRequest struct {
+	Email string `json:"email"`
+}
+
+// NewAuth creates AuthService which can be used without authentication, e.g.
+// before a user is registered and NewClient can be called.
+func NewAuth(baseURL *url.URL, opts ...ClientOption) *AuthService {
+	return newClient(baseURL, opts...).Auth
+}
+
+// Register registers a new user. The user should verify the email by the
+// token sent to it.
+func (s *AuthService) Register(ctx context.Context, data AuthRegisterRequest) (User, error) {
+	var resp userResponse
+	return resp.Data, s.client.create(ctx, "auth/register", data, &resp, withoutAuthentication())
+}
+
+// VerifyEmail verifies user's email by the token sent to it after registration.
+func (s *AuthService) VerifyEmail(ctx context.Context, token string) error {
+	return s.client.syncPost(
+		ctx,
+		"auth/email/verify",
+		withBody(authVerifyEmailRequest{Token: token}),
+		withoutAuthentication(),
+	)
+}
+
+// RequestPasswordReset sends an email with password reset token to the user.
+// Password recovery should be allowed by SettingsFeatures.AllowPasswordRecovery.
+func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) error {
+	return s.client.syncPost(
+		ctx,
+		"auth/password/forgot",
+		withBody(authRequestPasswordResetRequest{Email: email}),
+		withoutAuthentication(),
+	)
+}
+
+// ResetPassword completes password reset by the token sent by
+// RequestPasswordReset.
+func (s *AuthService) ResetPassword(ctx context.Context, data AuthResetPasswordRequest) error {
+	return s.client.syncPost(ctx, "auth/password/reset", withBody(data), withoutAuthentication())
+}
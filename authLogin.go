@@ -1,14 +1,20 @@
 package solus
 
 // AuthLoginRequest represents all required properties for authentication.
+// TwoFactorAuthCode should be specified only if the server requested it by
+// AuthLoginResponse.TwoFactorAuthRequired.
 type AuthLoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email             string `json:"email"`
+	Password          string `json:"password"`
+	TwoFactorAuthCode string `json:"two_factor_auth_code,omitempty"`
 }
 
 // AuthLoginResponse represents an authentication response.
+// Credentials are empty if TwoFactorAuthRequired is true, the login should be
+// repeated with a code in that case.
 type AuthLoginResponse struct {
-	Credentials Credentials `json:"credentials"`
+	Credentials           Credentials `json:"credentials"`
+	TwoFactorAuthRequired bool        `json:"two_factor_auth_required"`
 }
 
 // Credentials represents obtained credentials.
@@ -0,0 +1,116 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestAuth(t *testing.T, addr string) *AuthService {
+	t.Helper()
+
+	u, err := url.Parse(addr)
+	require.NoError(t, err)
+
+	return NewAuth(u, SetRetryPolicy(0, 0))
+}
+
+func TestNewAuth(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestAuth(t, s.URL).VerifyEmail(context.Background(), "token")
+	require.NoError(t, err)
+}
+
+func TestAuthService_AuthenticatedClient(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		writeResponse(t, w, http.StatusCreated, fakeUser)
+	})
+	defer s.Close()
+
+	c := createTestClient(t, s.URL)
+	c.SetCredentials(Credentials{AccessToken: "admin token", TokenType: "Bearer"})
+
+	_, err := c.Auth.Register(context.Background(), AuthRegisterRequest{Email: "test@example.com"})
+	require.NoError(t, err)
+	require.Equal(t, "Bearer admin token", c.Headers.Get("Authorization"))
+}
+
+func TestAuthService_Register(t *testing.T) {
+	data := AuthRegisterRequest{
+		Email:      "test@example.com",
+		Password:   "Pass80rd",
+		LanguageID: 1,
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/auth/register", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusCreated, fakeUser)
+	})
+	defer s.Close()
+
+	actual, err := createTestAuth(t, s.URL).Register(context.Background(), data)
+	require.NoError(t, err)
+	require.Equal(t, fakeUser, actual)
+}
+
+func TestAuthService_VerifyEmail(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/auth/email/verify", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, authVerifyEmailRequest{Token: "token"})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestAuth(t, s.URL).VerifyEmail(context.Background(), "token")
+	require.NoError(t, err)
+}
+
+func TestAuthService_RequestPasswordReset(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/auth/password/forgot", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, authRequestPasswordResetRequest{Email: "test@example.com"})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestAuth(t, s.URL).RequestPasswordReset(context.Background(), "test@example.com")
+	require.NoError(t, err)
+}
+
+func TestAuthService_ResetPassword(t *testing.T) {
+	data := AuthResetPasswordRequest{
+		Token:    "token",
+		Password: "Pass80rd",
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/auth/password/reset", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, data)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestAuth(t, s.URL).ResetPassword(context.Background(), data)
+	require.NoError(t, err)
+}
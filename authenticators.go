@@ -0,0 +1,290 @@
+package solus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var errTwoFactorAuthRequired = errors.New(
+	"two-factor authentication is required, use TwoFactorAuthAuthenticator",
+)
+
+// TwoFactorAuthAuthenticator authenticate with specified email and password
+// and handles two-factor authentication challenge.
+type TwoFactorAuthAuthenticator struct {
+	Email    string
+	Password string
+
+	// Code returns a TOTP code from an authenticator application. It's called
+	// only if two-factor authentication is enabled for the user.
+	Code func() (string, error)
+}
+
+var _ Authenticator = TwoFactorAuthAuthenticator{}
+
+// Authenticate authenticates by email and password and asks for a TOTP code if
+// the server requests it.
+func (a TwoFactorAuthAuthenticator) Authenticate(c *Client) (Credentials, error) {
+	data := AuthLoginRequest{
+		Email:    a.Email,
+		Password: a.Password,
+	}
+
+	resp, err := c.authLogin(context.Background(), data)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	if !resp.TwoFactorAuthRequired {
+		return resp.Credentials, nil
+	}
+
+	if a.Code == nil {
+		return Credentials{}, errTwoFactorAuthRequired
+	}
+
+	code, err := a.Code()
+	if err != nil {
+		return Credentials{}, fmt.Errorf("get two-factor authentication code: %w", err)
+	}
+	data.TwoFactorAuthCode = code
+
+	resp, err = c.authLogin(context.Background(), data)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	if resp.TwoFactorAuthRequired {
+		return Credentials{}, errors.New("invalid two-factor authentication code")
+	}
+	return resp.Credentials, nil
+}
+
+// parseCredentials parses credentials either in JSON format or as plain API
+// token.
+func parseCredentials(b []byte) (Credentials, error) {
+	s := strings.TrimSpace(string(b))
+	if s == "" {
+		return Credentials{}, errors.New("credentials are empty")
+	}
+
+	if !strings.HasPrefix(s, "{") {
+		return APITokenAuthenticator{Token: s}.Authenticate(nil)
+	}
+
+	var c Credentials
+	if err := json.Unmarshal([]byte(s), &c); err != nil {
+		return Credentials{}, fmt.Errorf("unmarshal credentials: %w", err)
+	}
+
+	if c.AccessToken == "" {
+		return Credentials{}, errors.New("access token is empty")
+	}
+
+	if c.TokenType == "" {
+		c.TokenType = "Bearer"
+	}
+	return c, nil
+}
+
+// EnvAuthenticator authenticate by credentials from specified environment
+// variable. The variable may contain either an API token or credentials in
+// JSON format.
+type EnvAuthenticator struct {
+	Name string
+}
+
+var _ Authenticator = EnvAuthenticator{}
+
+// Authenticate authenticates by credentials from the environment variable.
+func (a EnvAuthenticator) Authenticate(*Client) (Credentials, error) {
+	v, ok := os.LookupEnv(a.Name)
+	if !ok {
+		return Credentials{}, fmt.Errorf("environment variable %q isn't set", a.Name)
+	}
+
+	c, err := parseCredentials([]byte(v))
+	if err != nil {
+		return Credentials{}, fmt.Errorf("environment variable %q: %w", a.Name, err)
+	}
+	return c, nil
+}
+
+// FileAuthenticator authenticate by credentials from specified file. The file
+// may contain either an API token or credentials in JSON format.
+type FileAuthenticator struct {
+	Path string
+}
+
+var _ Authenticator = FileAuthenticator{}
+
+// Authenticate authenticates by credentials from the file.
+func (a FileAuthenticator) Authenticate(*Client) (Credentials, error) {
+	b, err := os.ReadFile(a.Path)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	c, err := parseCredentials(b)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("file %q: %w", a.Path, err)
+	}
+	return c, nil
+}
+
+// Watch reloads credentials into the client each time the file is changed.
+// The file is checked every Client.PollInterval, or every 5 seconds if it isn't
+// positive, until the context is done.
+// Credentials which failed to be loaded are logged and the client keeps using
+// the previous ones.
+func (a FileAuthenticator) Watch(ctx context.Context, c *Client) error {
+
+	modTime := func() time.Time {
+		fi, err := os.Stat(a.Path)
+		if err != nil {
+			return time.Time{}
+		}
+		return fi.ModTime()
+	}
+
+	last := modTime()
+	t := time.NewTicker(c.pollInterval())
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-t.C:
+			mt := modTime()
+			if mt.Equal(last) {
+				continue
+			}
+			last = mt
+
+			creds, err := a.Authenticate(c)
+			if err != nil {
+				c.Logger.Errorf("failed to reload credentials: %s", err)
+				continue
+			}
+			c.SetCredentials(creds)
+		}
+	}
+}
+
+// ChainAuthenticator tries authenticators in specified order and uses
+// credentials from the first succeeded one.
+type ChainAuthenticator []Authenticator
+
+var _ Authenticator = ChainAuthenticator{}
+
+// Authenticate authenticates by the first succeeded authenticator.
+func (a ChainAuthenticator) Authenticate(c *Client) (Credentials, error) {
+	if len(a) == 0 {
+		return Credentials{}, errors.New("no authenticators are specified")
+	}
+
+	// Messages of all errors are joined, only the last error is wrapped.
+	var (
+		prev    strings.Builder
+		lastErr error
+	)
+	for i, auth := range a {
+		creds, err := auth.Authenticate(c)
+		if err == nil {
+			return creds, nil
+		}
+
+		if lastErr != nil {
+			fmt.Fprintf(&prev, "authenticator #%d: %s\n", i-1, lastErr)
+		}
+		lastErr = err
+	}
+	return Credentials{}, fmt.Errorf(
+		"all authenticators failed: %sauthenticator #%d: %w",
+		prev.String(),
+		len(a)-1,
+		lastErr,
+	)
+}
+
+// CachedAuthenticator caches credentials obtained by the wrapped authenticator
+// in specified file, so they can be reused between CLI invocations.
+// Cached credentials are used until they expire according to
+// Credentials.ExpiresAt, credentials without expiration time are used until
+// the file is removed or Forget is called.
+type CachedAuthenticator struct {
+	Authenticator Authenticator
+	Path          string
+}
+
+var _ Authenticator = CachedAuthenticator{}
+
+// Authenticate returns cached credentials or authenticates by the wrapped
+// authenticator and caches obtained credentials.
+func (a CachedAuthenticator) Authenticate(c *Client) (Credentials, error) {
+	if creds, ok := a.load(); ok {
+		return creds, nil
+	}
+
+	creds, err := a.Authenticator.Authenticate(c)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	if err := a.store(creds); err != nil {
+		c.Logger.Errorf("failed to cache credentials: %s", err)
+	}
+	return creds, nil
+}
+
+// Forget removes cached credentials, so the next Authenticate call
+// authenticates by the wrapped authenticator. It should be called when cached
+// credentials are rejected, for instance if they were revoked.
+func (a CachedAuthenticator) Forget() error {
+	if err := os.Remove(a.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (a CachedAuthenticator) load() (Credentials, bool) {
+	b, err := os.ReadFile(a.Path)
+	if err != nil {
+		return Credentials{}, false
+	}
+
+	var creds Credentials
+	if err := json.Unmarshal(b, &creds); err != nil || creds.AccessToken == "" {
+		return Credentials{}, false
+	}
+
+	if creds.ExpiresAt == "" {
+		return creds, true
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339Nano, creds.ExpiresAt)
+	if err != nil || !time.Now().Before(expiresAt) {
+		return Credentials{}, false
+	}
+	return creds, true
+}
+
+func (a CachedAuthenticator) store(creds Credentials) error {
+	b, err := json.Marshal(creds)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(a.Path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(a.Path, b, 0o600)
+}
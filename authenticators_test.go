@@ -0,0 +1,419 @@
+package solus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwoFactorAuthAuthenticator_Authenticate(t *testing.T) {
+	credentials := Credentials{
+		AccessToken: "access token",
+		TokenType:   "Bearer",
+		ExpiresAt:   "2030-01-01T00:00:00Z",
+	}
+
+	handler := func(t *testing.T) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/auth/login", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var req AuthLoginRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "test@example.com", req.Email)
+			assert.Equal(t, "Pass80rd", req.Password)
+
+			if req.TwoFactorAuthCode != "123456" {
+				writeResponse(t, w, http.StatusOK, AuthLoginResponse{TwoFactorAuthRequired: true})
+				return
+			}
+			writeResponse(t, w, http.StatusOK, AuthLoginResponse{Credentials: credentials})
+		}
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, handler(t))
+		defer s.Close()
+
+		actual, err := TwoFactorAuthAuthenticator{
+			Email:    "test@example.com",
+			Password: "Pass80rd",
+			Code:     func() (string, error) { return "123456", nil },
+		}.Authenticate(createTestClient(t, s.URL))
+		require.NoError(t, err)
+		require.Equal(t, credentials, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		s := startTestServer(t, handler(t))
+		defer s.Close()
+
+		testCases := map[string]struct {
+			code     func() (string, error)
+			expected string
+		}{
+			"without callback": {
+				expected: "two-factor authentication is required, use TwoFactorAuthAuthenticator",
+			},
+			"callback failed": {
+				code:     func() (string, error) { return "", errors.New("fake error") },
+				expected: "get two-factor authentication code: fake error",
+			},
+			"invalid code": {
+				code:     func() (string, error) { return "000000", nil },
+				expected: "invalid two-factor authentication code",
+			},
+		}
+
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				_, err := TwoFactorAuthAuthenticator{
+					Email:    "test@example.com",
+					Password: "Pass80rd",
+					Code:     tc.code,
+				}.Authenticate(createTestClient(t, s.URL))
+				require.EqualError(t, err, tc.expected)
+			})
+		}
+	})
+}
+
+func TestEmailAndPasswordAuthenticator_Authenticate_TwoFactorAuthRequired(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeResponse(t, w, http.StatusOK, AuthLoginResponse{TwoFactorAuthRequired: true})
+	})
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	_, err = NewClient(u, EmailAndPasswordAuthenticator{Email: "test@example.com", Password: "Pass80rd"})
+	require.EqualError(t, err, "authenticate: two-factor authentication is required, use TwoFactorAuthAuthenticator")
+}
+
+func TestParseCredentials(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		testCases := map[string]struct {
+			given    string
+			expected Credentials
+		}{
+			"token": {
+				given:    " token\n",
+				expected: Credentials{AccessToken: "token", TokenType: "Bearer"},
+			},
+			"json": {
+				given: `{"access_token":"token","token_type":"Custom","expires_at":"2030-01-01T00:00:00Z"}`,
+				expected: Credentials{
+					AccessToken: "token",
+					TokenType:   "Custom",
+					ExpiresAt:   "2030-01-01T00:00:00Z",
+				},
+			},
+			"json without token type": {
+				given:    `{"access_token":"token"}`,
+				expected: Credentials{AccessToken: "token", TokenType: "Bearer"},
+			},
+		}
+
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				actual, err := parseCredentials([]byte(tc.given))
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, actual)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		testCases := map[string]struct {
+			given    string
+			expected string
+		}{
+			"empty": {
+				given:    " \n",
+				expected: "credentials are empty",
+			},
+			"invalid json": {
+				given:    "{",
+				expected: "unmarshal credentials: unexpected end of JSON input",
+			},
+			"empty access token": {
+				given:    `{"token_type":"Bearer"}`,
+				expected: "access token is empty",
+			},
+		}
+
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				_, err := parseCredentials([]byte(tc.given))
+				require.EqualError(t, err, tc.expected)
+			})
+		}
+	})
+}
+
+func TestEnvAuthenticator_Authenticate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		t.Setenv("SOLUS_TEST_TOKEN", "token")
+
+		actual, err := EnvAuthenticator{Name: "SOLUS_TEST_TOKEN"}.Authenticate(nil)
+		require.NoError(t, err)
+		require.Equal(t, Credentials{AccessToken: "token", TokenType: "Bearer"}, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("not set", func(t *testing.T) {
+			_, err := EnvAuthenticator{Name: "SOLUS_TEST_UNSET_TOKEN"}.Authenticate(nil)
+			require.EqualError(t, err, `environment variable "SOLUS_TEST_UNSET_TOKEN" isn't set`)
+		})
+
+		t.Run("empty", func(t *testing.T) {
+			t.Setenv("SOLUS_TEST_TOKEN", "")
+
+			_, err := EnvAuthenticator{Name: "SOLUS_TEST_TOKEN"}.Authenticate(nil)
+			require.EqualError(t, err, `environment variable "SOLUS_TEST_TOKEN": credentials are empty`)
+		})
+	})
+}
+
+func TestFileAuthenticator_Authenticate(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "token")
+
+	t.Run("positive", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(p, []byte("token\n"), 0o600))
+
+		actual, err := FileAuthenticator{Path: p}.Authenticate(nil)
+		require.NoError(t, err)
+		require.Equal(t, Credentials{AccessToken: "token", TokenType: "Bearer"}, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("not exists", func(t *testing.T) {
+			_, err := FileAuthenticator{Path: p + ".missing"}.Authenticate(nil)
+			require.ErrorIs(t, err, os.ErrNotExist)
+		})
+
+		t.Run("empty", func(t *testing.T) {
+			require.NoError(t, os.WriteFile(p, nil, 0o600))
+
+			_, err := FileAuthenticator{Path: p}.Authenticate(nil)
+			require.EqualError(t, err, `file "`+p+`": credentials are empty`)
+		})
+	})
+}
+
+func TestFileAuthenticator_Watch(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(p, []byte("old"), 0o600))
+
+	a := FileAuthenticator{Path: p}
+	c := createTestClient(t, "")
+	creds, err := a.Authenticate(c)
+	require.NoError(t, err)
+	c.SetCredentials(creds)
+
+	errs := make(chan string, 1)
+	c.Logger = chanLogger(errs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- a.Watch(ctx, c)
+	}()
+
+	currentToken := func() string {
+		c.credentialsMu.RLock()
+		defer c.credentialsMu.RUnlock()
+		return c.Credentials.AccessToken
+	}
+
+	// Invalid credentials are ignored. The file is touched until the error is
+	// reported, since the watcher may start after the first change.
+	require.NoError(t, os.WriteFile(p, nil, 0o600))
+	mt := time.Now()
+	require.Eventually(t, func() bool {
+		mt = mt.Add(time.Minute)
+		require.NoError(t, os.Chtimes(p, mt, mt))
+
+		select {
+		case msg := <-errs:
+			return strings.HasPrefix(msg, "failed to reload credentials")
+		default:
+			return false
+		}
+	}, time.Second, time.Millisecond)
+	require.Equal(t, "old", currentToken())
+
+	require.NoError(t, os.WriteFile(p, []byte("new"), 0o600))
+	require.NoError(t, os.Chtimes(p, mt, mt.Add(time.Minute)))
+	require.Eventually(t, func() bool {
+		return currentToken() == "new"
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestFileAuthenticator_Watch_InvalidPollInterval(t *testing.T) {
+	c := createTestClient(t, "")
+	c.PollInterval = 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := FileAuthenticator{Path: filepath.Join(t.TempDir(), "token")}.Watch(ctx, c)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+// chanLogger sends logged errors to the channel. Errors are dropped if the
+// channel is full.
+type chanLogger chan string
+
+func (chanLogger) Debugf(string, ...interface{}) {}
+
+func (l chanLogger) Errorf(format string, args ...interface{}) {
+	select {
+	case l <- fmt.Sprintf(format, args...):
+	default:
+	}
+}
+
+type fakeAuthenticator struct {
+	credentials Credentials
+	err         error
+	calls       *int
+}
+
+func (a fakeAuthenticator) Authenticate(*Client) (Credentials, error) {
+	if a.calls != nil {
+		*a.calls++
+	}
+	return a.credentials, a.err
+}
+
+func TestChainAuthenticator_Authenticate(t *testing.T) {
+	credentials := Credentials{AccessToken: "token", TokenType: "Bearer"}
+
+	t.Run("positive", func(t *testing.T) {
+		calls := 0
+
+		actual, err := ChainAuthenticator{
+			fakeAuthenticator{err: errors.New("fake error")},
+			fakeAuthenticator{credentials: credentials},
+			fakeAuthenticator{calls: &calls},
+		}.Authenticate(nil)
+		require.NoError(t, err)
+		require.Equal(t, credentials, actual)
+		require.Equal(t, 0, calls)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("all failed", func(t *testing.T) {
+			_, err := ChainAuthenticator{
+				fakeAuthenticator{err: errors.New("foo")},
+				fakeAuthenticator{err: errors.New("bar")},
+			}.Authenticate(nil)
+			require.EqualError(t, err, "all authenticators failed: authenticator #0: foo\nauthenticator #1: bar")
+		})
+
+		t.Run("empty", func(t *testing.T) {
+			_, err := ChainAuthenticator{}.Authenticate(nil)
+			require.EqualError(t, err, "no authenticators are specified")
+		})
+	})
+}
+
+func TestCachedAuthenticator_Authenticate(t *testing.T) {
+	credentials := Credentials{
+		AccessToken: "token",
+		TokenType:   "Bearer",
+		ExpiresAt:   time.Now().Add(time.Hour).UTC().Format(time.RFC3339Nano),
+	}
+
+	t.Run("caches credentials", func(t *testing.T) {
+		p := filepath.Join(t.TempDir(), "cache", "credentials.json")
+		calls := 0
+		a := CachedAuthenticator{
+			Authenticator: fakeAuthenticator{credentials: credentials, calls: &calls},
+			Path:          p,
+		}
+
+		for i := 0; i < 2; i++ {
+			actual, err := a.Authenticate(createTestClient(t, ""))
+			require.NoError(t, err)
+			require.Equal(t, credentials, actual)
+		}
+		require.Equal(t, 1, calls)
+
+		fi, err := os.Stat(p)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), fi.Mode().Perm())
+	})
+
+	t.Run("expired credentials", func(t *testing.T) {
+		p := filepath.Join(t.TempDir(), "credentials.json")
+		require.NoError(t, os.WriteFile(
+			p,
+			[]byte(`{"access_token":"expired","token_type":"Bearer","expires_at":"2000-01-01T00:00:00Z"}`),
+			0o600,
+		))
+
+		calls := 0
+		actual, err := CachedAuthenticator{
+			Authenticator: fakeAuthenticator{credentials: credentials, calls: &calls},
+			Path:          p,
+		}.Authenticate(createTestClient(t, ""))
+		require.NoError(t, err)
+		require.Equal(t, credentials, actual)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("credentials without expiration", func(t *testing.T) {
+		p := filepath.Join(t.TempDir(), "credentials.json")
+		require.NoError(t, os.WriteFile(p, []byte(`{"access_token":"cached","token_type":"Bearer"}`), 0o600))
+
+		actual, err := CachedAuthenticator{
+			Authenticator: fakeAuthenticator{err: errors.New("shouldn't be called")},
+			Path:          p,
+		}.Authenticate(createTestClient(t, ""))
+		require.NoError(t, err)
+		require.Equal(t, Credentials{AccessToken: "cached", TokenType: "Bearer"}, actual)
+	})
+
+	t.Run("forget", func(t *testing.T) {
+		p := filepath.Join(t.TempDir(), "credentials.json")
+		require.NoError(t, os.WriteFile(p, []byte(`{"access_token":"revoked","token_type":"Bearer"}`), 0o600))
+
+		calls := 0
+		a := CachedAuthenticator{
+			Authenticator: fakeAuthenticator{credentials: credentials, calls: &calls},
+			Path:          p,
+		}
+		require.NoError(t, a.Forget())
+		require.NoError(t, a.Forget(), "forgetting absent credentials should succeed")
+
+		actual, err := a.Authenticate(createTestClient(t, ""))
+		require.NoError(t, err)
+		require.Equal(t, credentials, actual)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("failed to authenticate", func(t *testing.T) {
+		_, err := CachedAuthenticator{
+			Authenticator: fakeAuthenticator{err: errors.New("fake error")},
+			Path:          filepath.Join(t.TempDir(), "credentials.json"),
+		}.Authenticate(createTestClient(t, ""))
+		require.EqualError(t, err, "fake error")
+	})
+}
@@ -11,14 +11,14 @@ type BackupNodesService service
 // BackupNode represents a backup node.
 // The backup node is a server or a service where backup can be stored.
 type BackupNode struct {
-	ID                    int                    `json:"id"`
-	Name                  string                 `json:"name"`
-	Type                  BackupNodeType         `json:"type"`
-	Credentials           map[string]interface{} `json:"credentials"`
-	ComputeResourcesCount int                    `json:"compute_resources_count"`
-	BackupsCount          int                    `json:"backups_count"`
-	TotalBackupsSize      int                    `json:"total_backups_size"`
-	ComputeResources      []ComputeResource      `json:"compute_resources"`
+	ID                    int                   `json:"id"`
+	Name                  string                `json:"name"`
+	Type                  BackupNodeType        `json:"type"`
+	Credentials           BackupNodeCredentials `json:"credentials"`
+	ComputeResourcesCount int                   `json:"compute_resources_count"`
+	BackupsCount          int                   `json:"backups_count"`
+	TotalBackupsSize      int                   `json:"total_backups_size"`
+	ComputeResources      []ComputeResource     `json:"compute_resources"`
 }
 
 // BackupNodeType a backup node type.
@@ -37,59 +37,70 @@ const (
 // BackupNodeRequest represents available properties for creating new or updating
 // existing backup nodes.
 type BackupNodeRequest struct {
-	Name             string                 `json:"name"`
-	Type             BackupNodeType         `json:"type"`
-	ComputeResources []int                  `json:"compute_resources,omitempty"`
-	Credentials      map[string]interface{} `json:"credentials,omitempty"`
+	Name             string                `json:"name"`
+	Type             BackupNodeType        `json:"type"`
+	ComputeResources []int                 `json:"compute_resources,omitempty"`
+	Credentials      BackupNodeCredentials `json:"credentials,omitempty"`
 }
 
-// BackupNodeSSHRsyncCredentials creates SSH+Rsync specific connection credentials.
-func BackupNodeSSHRsyncCredentials(
-	host string,
-	port int,
-	login string,
-	key string,
-	storagePath string,
-) map[string]interface{} {
-	return map[string]interface{}{
-		"host":         host,
-		"port":         port,
-		"login":        login,
-		"key":          key,
-		"storage_path": storagePath,
-	}
-}
+// BackupNodesResponse represents paginated list of backup nodes.
+// This cursor can be used for iterating over all available backup nodes.
+type BackupNodesResponse struct {
+	paginatedResponse
 
-// BackupNodeHetznerStorageBoxCredentials creates Hetzner Storage Box specific
-// connection credentials.
-func BackupNodeHetznerStorageBoxCredentials(
-	host string,
-	login string,
-	key string,
-) map[string]interface{} {
-	return map[string]interface{}{
-		"host":  host,
-		"login": login,
-		"key":   key,
-	}
+	Data []BackupNode `json:"data"`
 }
 
 type backupNodeResponse struct {
 	Data BackupNode `json:"data"`
 }
 
+// List lists backup nodes.
+func (s *BackupNodesService) List(ctx context.Context) (BackupNodesResponse, error) {
+	resp := BackupNodesResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, "backup_nodes", &resp)
+}
+
+// Get gets specified backup node.
+func (s *BackupNodesService) Get(ctx context.Context, id int) (BackupNode, error) {
+	var resp backupNodeResponse
+	return resp.Data, s.client.get(ctx, fmt.Sprintf("backup_nodes/%d", id), &resp)
+}
+
 // Create creates new backup node.
 func (s *BackupNodesService) Create(ctx context.Context, data BackupNodeRequest) (BackupNode, error) {
+	if err := data.Validate(); err != nil {
+		return BackupNode{}, err
+	}
+
 	var resp backupNodeResponse
 	return resp.Data, s.client.create(ctx, "backup_nodes", data, &resp)
 }
 
 // Update updates specified backup node.
 func (s *BackupNodesService) Update(ctx context.Context, id int, data BackupNodeRequest) (BackupNode, error) {
+	if err := data.Validate(); err != nil {
+		return BackupNode{}, err
+	}
+
 	var resp backupNodeResponse
 	return resp.Data, s.client.update(ctx, fmt.Sprintf("backup_nodes/%d", id), data, &resp)
 }
 
+// Backups lists backups stored on the specified backup node.
+func (s *BackupNodesService) Backups(ctx context.Context, id int) (BackupsResponse, error) {
+	resp := BackupsResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, fmt.Sprintf("backup_nodes/%d/backups", id), &resp)
+}
+
 // Delete deletes specified backup node.
 func (s *BackupNodesService) Delete(ctx context.Context, id int) error {
 	return s.client.syncDelete(ctx, fmt.Sprintf("backup_nodes/%d", id))
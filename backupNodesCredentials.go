@@ -0,0 +1,214 @@
+package solus
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// BackupNodeCredentials represents connection credentials of a backup node.
+// Concrete type of the credentials depends on the backup node type.
+type BackupNodeCredentials interface {
+	// Validate checks all required credentials are specified.
+	Validate() error
+
+	backupNodeType() BackupNodeType
+}
+
+// BackupNodeCredentialsSSHRsync represents SSH+Rsync specific connection
+// credentials.
+type BackupNodeCredentialsSSHRsync struct {
+	Host        string `json:"host"`
+	Port        int    `json:"port"`
+	Login       string `json:"login"`
+	Key         string `json:"key"`
+	StoragePath string `json:"storage_path"`
+}
+
+var _ BackupNodeCredentials = BackupNodeCredentialsSSHRsync{}
+
+// BackupNodeSSHRsyncCredentials creates SSH+Rsync specific connection
+// credentials.
+//
+// Deprecated: use BackupNodeCredentialsSSHRsync instead.
+func BackupNodeSSHRsyncCredentials(
+	host string,
+	port int,
+	login string,
+	key string,
+	storagePath string,
+) BackupNodeCredentialsSSHRsync {
+	return BackupNodeCredentialsSSHRsync{
+		Host:        host,
+		Port:        port,
+		Login:       login,
+		Key:         key,
+		StoragePath: storagePath,
+	}
+}
+
+// Validate checks all required credentials are specified.
+func (c BackupNodeCredentialsSSHRsync) Validate() error {
+	if err := requireCredentials(
+		requiredCredential{"host", c.Host},
+		requiredCredential{"login", c.Login},
+		requiredCredential{"key", c.Key},
+		requiredCredential{"storage_path", c.StoragePath},
+	); err != nil {
+		return err
+	}
+
+	if c.Port < 1 || c.Port > 65535 {
+		return fmt.Errorf("invalid port %d", c.Port)
+	}
+	return nil
+}
+
+func (BackupNodeCredentialsSSHRsync) backupNodeType() BackupNodeType {
+	return BackupNodeTypeSSHRsync
+}
+
+// BackupNodeCredentialsHetznerStorageBox represents Hetzner Storage Box
+// specific connection credentials.
+type BackupNodeCredentialsHetznerStorageBox struct {
+	Host  string `json:"host"`
+	Login string `json:"login"`
+	Key   string `json:"key"`
+}
+
+var _ BackupNodeCredentials = BackupNodeCredentialsHetznerStorageBox{}
+
+// BackupNodeHetznerStorageBoxCredentials creates Hetzner Storage Box
+// specific connection credentials.
+//
+// Deprecated: use BackupNodeCredentialsHetznerStorageBox instead.
+func BackupNodeHetznerStorageBoxCredentials(
+	host string,
+	login string,
+	key string,
+) BackupNodeCredentialsHetznerStorageBox {
+	return BackupNodeCredentialsHetznerStorageBox{
+		Host:  host,
+		Login: login,
+		Key:   key,
+	}
+}
+
+// Validate checks all required credentials are specified.
+func (c BackupNodeCredentialsHetznerStorageBox) Validate() error {
+	return requireCredentials(
+		requiredCredential{"host", c.Host},
+		requiredCredential{"login", c.Login},
+		requiredCredential{"key", c.Key},
+	)
+}
+
+func (BackupNodeCredentialsHetznerStorageBox) backupNodeType() BackupNodeType {
+	return BackupNodeTypeHetznerStorageBox
+}
+
+// BackupNodeRawCredentials represents credentials of a backup node type which
+// is unknown to the client.
+type BackupNodeRawCredentials map[string]interface{}
+
+var _ BackupNodeCredentials = BackupNodeRawCredentials{}
+
+// Validate always succeeds since the client doesn't know which credentials
+// are required.
+func (BackupNodeRawCredentials) Validate() error { return nil }
+
+func (BackupNodeRawCredentials) backupNodeType() BackupNodeType { return "" }
+
+// UnmarshalJSON decodes the backup node with credentials specific to its type.
+func (n *BackupNode) UnmarshalJSON(b []byte) error {
+	type alias BackupNode
+	var v struct {
+		alias
+
+		Credentials json.RawMessage `json:"credentials"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	c, err := decodeBackupNodeCredentials(v.Type, v.Credentials)
+	if err != nil {
+		return err
+	}
+
+	*n = BackupNode(v.alias)
+	n.Credentials = c
+	return nil
+}
+
+func decodeBackupNodeCredentials(t BackupNodeType, raw json.RawMessage) (BackupNodeCredentials, error) {
+	if isEmptyCredentials(raw) {
+		return nil, nil
+	}
+
+	var (
+		c   BackupNodeCredentials
+		err error
+	)
+	switch t {
+	case BackupNodeTypeSSHRsync:
+		var v BackupNodeCredentialsSSHRsync
+		err = json.Unmarshal(raw, &v)
+		c = v
+
+	case BackupNodeTypeHetznerStorageBox:
+		var v BackupNodeCredentialsHetznerStorageBox
+		err = json.Unmarshal(raw, &v)
+		c = v
+
+	default:
+		var v BackupNodeRawCredentials
+		err = json.Unmarshal(raw, &v)
+		c = v
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode %q backup node credentials: %w", t, err)
+	}
+	return c, nil
+}
+
+// Validate checks the credentials are valid and match the backup node type.
+func (r BackupNodeRequest) Validate() error {
+	if r.Credentials == nil {
+		return nil
+	}
+
+	if t := r.Credentials.backupNodeType(); t != "" && t != r.Type {
+		return fmt.Errorf("%q credentials can't be used for %q backup node", t, r.Type)
+	}
+
+	if err := r.Credentials.Validate(); err != nil {
+		return fmt.Errorf("invalid credentials: %w", err)
+	}
+	return nil
+}
+
+// isEmptyCredentials checks the raw credentials are absent. API may return
+// an empty array instead of an empty object.
+func isEmptyCredentials(raw json.RawMessage) bool {
+	switch string(raw) {
+	case "", "null", "[]", "{}":
+		return true
+	}
+	return false
+}
+
+// requiredCredential represents a credential which shouldn't be empty.
+type requiredCredential struct {
+	name  string
+	value string
+}
+
+// requireCredentials checks all specified credentials aren't empty.
+func requireCredentials(cc ...requiredCredential) error {
+	for _, c := range cc {
+		if c.value == "" {
+			return fmt.Errorf("%s is required", c.name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package solus
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupNodeCredentialsSSHRsync_Validate(t *testing.T) {
+	c := BackupNodeCredentialsSSHRsync{
+		Host:        "example.com",
+		Port:        22,
+		Login:       "root",
+		Key:         "private key",
+		StoragePath: "/foo/bar",
+	}
+	require.NoError(t, c.Validate())
+
+	c.Port = 0
+	require.EqualError(t, c.Validate(), "invalid port 0")
+
+	c.StoragePath = ""
+	require.EqualError(t, c.Validate(), "storage_path is required")
+}
+
+func TestBackupNodeCredentialsHetznerStorageBox_Validate(t *testing.T) {
+	c := BackupNodeCredentialsHetznerStorageBox{
+		Host:  "example.com",
+		Login: "root",
+		Key:   "private key",
+	}
+	require.NoError(t, c.Validate())
+
+	c.Login = ""
+	require.EqualError(t, c.Validate(), "login is required")
+}
+
+func TestBackupNodeSSHRsyncCredentials(t *testing.T) {
+	//nolint:staticcheck // The deprecated constructor should keep working.
+	actual := BackupNodeSSHRsyncCredentials("example.com", 22, "root", "key", "/foo")
+	require.Equal(t, BackupNodeCredentialsSSHRsync{
+		Host:        "example.com",
+		Port:        22,
+		Login:       "root",
+		Key:         "key",
+		StoragePath: "/foo",
+	}, actual)
+}
+
+func TestBackupNodeHetznerStorageBoxCredentials(t *testing.T) {
+	//nolint:staticcheck // The deprecated constructor should keep working.
+	actual := BackupNodeHetznerStorageBoxCredentials("example.com", "root", "key")
+	require.Equal(t, BackupNodeCredentialsHetznerStorageBox{
+		Host:  "example.com",
+		Login: "root",
+		Key:   "key",
+	}, actual)
+}
+
+func TestBackupNodeRequest_Validate(t *testing.T) {
+	cc := map[string]struct {
+		request  BackupNodeRequest
+		expected string
+	}{
+		"without credentials": {
+			request: BackupNodeRequest{Type: BackupNodeTypeSSHRsync},
+		},
+		"raw credentials": {
+			request: BackupNodeRequest{
+				Type:        BackupNodeTypeSSHRsync,
+				Credentials: BackupNodeRawCredentials{"foo": "bar"},
+			},
+		},
+		"type mismatch": {
+			request: BackupNodeRequest{
+				Type:        BackupNodeTypeSSHRsync,
+				Credentials: BackupNodeCredentialsHetznerStorageBox{},
+			},
+			expected: `"hetzner_storage_box" credentials can't be used for "ssh_rsync" backup node`,
+		},
+		"invalid credentials": {
+			request: BackupNodeRequest{
+				Type:        BackupNodeTypeHetznerStorageBox,
+				Credentials: BackupNodeCredentialsHetznerStorageBox{},
+			},
+			expected: "invalid credentials: host is required",
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			err := c.request.Validate()
+			if c.expected == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, c.expected)
+		})
+	}
+}
+
+func TestBackupNodeRequest_MarshalJSON(t *testing.T) {
+	cc := map[string]struct {
+		given    BackupNodeRequest
+		expected string
+	}{
+		"ssh rsync": {
+			given: BackupNodeRequest{
+				Name: "name",
+				Type: BackupNodeTypeSSHRsync,
+				Credentials: BackupNodeCredentialsSSHRsync{
+					Host:        "example.com",
+					Port:        22,
+					Login:       "root",
+					Key:         "key",
+					StoragePath: "/foo",
+				},
+			},
+			expected: `{"name":"name","type":"ssh_rsync","credentials":{` +
+				`"host":"example.com","port":22,"login":"root","key":"key","storage_path":"/foo"}}`,
+		},
+		"hetzner storage box": {
+			given: BackupNodeRequest{
+				Name: "name",
+				Type: BackupNodeTypeHetznerStorageBox,
+				Credentials: BackupNodeCredentialsHetznerStorageBox{
+					Host:  "example.com",
+					Login: "root",
+					Key:   "key",
+				},
+			},
+			expected: `{"name":"name","type":"hetzner_storage_box","credentials":{` +
+				`"host":"example.com","login":"root","key":"key"}}`,
+		},
+		"raw credentials": {
+			given: BackupNodeRequest{
+				Name:        "name",
+				Type:        "foo",
+				Credentials: BackupNodeRawCredentials{"foo": "bar"},
+			},
+			expected: `{"name":"name","type":"foo","credentials":{"foo":"bar"}}`,
+		},
+		"without credentials": {
+			given:    BackupNodeRequest{Name: "name", Type: BackupNodeTypeSSHRsync},
+			expected: `{"name":"name","type":"ssh_rsync"}`,
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(c.given)
+			require.NoError(t, err)
+			require.JSONEq(t, c.expected, string(b))
+		})
+	}
+}
+
+func TestBackupNode_UnmarshalJSON(t *testing.T) {
+	cc := map[string]struct {
+		given    string
+		expected BackupNode
+	}{
+		"ssh rsync": {
+			given: `{"id":1,"type":"ssh_rsync","credentials":{` +
+				`"host":"example.com","port":22,"login":"root","key":"key","storage_path":"/foo"}}`,
+			expected: BackupNode{
+				ID:   1,
+				Type: BackupNodeTypeSSHRsync,
+				Credentials: BackupNodeCredentialsSSHRsync{
+					Host:        "example.com",
+					Port:        22,
+					Login:       "root",
+					Key:         "key",
+					StoragePath: "/foo",
+				},
+			},
+		},
+		"hetzner storage box": {
+			given: `{"id":1,"type":"hetzner_storage_box","credentials":{` +
+				`"host":"example.com","login":"root","key":"key"}}`,
+			expected: BackupNode{
+				ID:   1,
+				Type: BackupNodeTypeHetznerStorageBox,
+				Credentials: BackupNodeCredentialsHetznerStorageBox{
+					Host:  "example.com",
+					Login: "root",
+					Key:   "key",
+				},
+			},
+		},
+		"unknown type": {
+			given: `{"id":1,"type":"foo","credentials":{"foo":"bar"}}`,
+			expected: BackupNode{
+				ID:          1,
+				Type:        "foo",
+				Credentials: BackupNodeRawCredentials{"foo": "bar"},
+			},
+		},
+		"empty credentials": {
+			given:    `{"id":1,"type":"ssh_rsync","credentials":[]}`,
+			expected: BackupNode{ID: 1, Type: BackupNodeTypeSSHRsync},
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			var actual BackupNode
+			require.NoError(t, json.Unmarshal([]byte(c.given), &actual))
+			require.Equal(t, c.expected, actual)
+		})
+	}
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		var actual BackupNode
+		err := json.Unmarshal([]byte(`{"type":"ssh_rsync","credentials":{"port":"22"}}`), &actual)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `decode "ssh_rsync" backup node credentials`)
+	})
+}
+
+func TestBackupNodesService_Create_Validate(t *testing.T) {
+	_, err := createTestClient(t, "http://example.com").BackupNodes.Create(
+		context.Background(),
+		BackupNodeRequest{
+			Type:        BackupNodeTypeSSHRsync,
+			Credentials: BackupNodeCredentialsSSHRsync{},
+		},
+	)
+	require.EqualError(t, err, "invalid credentials: host is required")
+}
@@ -0,0 +1,104 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// BackupNodeUsage represents utilization of a backup node.
+// Only successfully created backups are taken into account.
+type BackupNodeUsage struct {
+	BackupNode   BackupNode
+	BackupsCount int
+	TotalSize    float64
+
+	// Servers maps a virtual server ID to total size of its backups.
+	Servers map[int]float64
+
+	// Users maps a user ID to total size of backups of the user's servers.
+	Users map[int]float64
+}
+
+func (u *BackupNodeUsage) add(b Backup) {
+	if b.Status != BackupStatusCreated {
+		return
+	}
+
+	size := float64(b.Size)
+	u.BackupsCount++
+	u.TotalSize += size
+	u.Servers[b.ComputeResourceVM.ID] += size
+	u.Users[b.ComputeResourceVM.User.ID] += size
+}
+
+// Usage calculates utilization of the specified backup node by iterating
+// over all backups stored on it.
+func (s *BackupNodesService) Usage(ctx context.Context, id int) (BackupNodeUsage, error) {
+	node, err := s.Get(ctx, id)
+	if err != nil {
+		return BackupNodeUsage{}, err
+	}
+	return s.usage(ctx, node)
+}
+
+// UsageReport calculates utilization of all backup nodes. The result is
+// sorted by total size of backups in descending order, so the most utilized
+// backup nodes go first.
+func (s *BackupNodesService) UsageReport(ctx context.Context) ([]BackupNodeUsage, error) {
+	resp, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []BackupNode
+	for {
+		nodes = append(nodes, resp.Data...)
+		if !resp.Next(ctx) {
+			break
+		}
+	}
+	if resp.Err() != nil {
+		return nil, resp.Err()
+	}
+
+	report := make([]BackupNodeUsage, 0, len(nodes))
+	for _, n := range nodes {
+		u, err := s.usage(ctx, n)
+		if err != nil {
+			return nil, fmt.Errorf("calculate usage of backup node %d: %w", n.ID, err)
+		}
+		report = append(report, u)
+	}
+
+	sort.SliceStable(report, func(i, j int) bool {
+		return report[i].TotalSize > report[j].TotalSize
+	})
+	return report, nil
+}
+
+func (s *BackupNodesService) usage(ctx context.Context, node BackupNode) (BackupNodeUsage, error) {
+	u := BackupNodeUsage{
+		BackupNode: node,
+		Servers:    map[int]float64{},
+		Users:      map[int]float64{},
+	}
+
+	resp, err := s.Backups(ctx, node.ID)
+	if err != nil {
+		return BackupNodeUsage{}, err
+	}
+
+	for {
+		for _, b := range resp.Data {
+			u.add(b)
+		}
+		if !resp.Next(ctx) {
+			break
+		}
+	}
+	if resp.Err() != nil {
+		return BackupNodeUsage{}, resp.Err()
+	}
+	return u, nil
+}
@@ -0,0 +1,135 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeSizedBackup(serverID, userID int, size float32, status BackupStatus) Backup {
+	return Backup{
+		Status: status,
+		Size:   size,
+		ComputeResourceVM: VirtualServer{
+			ID:   serverID,
+			User: User{ID: userID},
+		},
+	}
+}
+
+func backupNodesUsageTestHandler(t *testing.T) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		switch r.URL.Path {
+		case "/backup_nodes":
+			writeJSON(t, w, http.StatusOK, BackupNodesResponse{
+				Data: []BackupNode{{ID: 1}, {ID: 2}},
+			})
+
+		case "/backup_nodes/1":
+			writeResponse(t, w, http.StatusOK, BackupNode{ID: 1})
+
+		case "/backup_nodes/1/backups":
+			writeJSON(t, w, http.StatusOK, BackupsResponse{
+				Data: []Backup{
+					fakeSizedBackup(10, 100, 1, BackupStatusCreated),
+					fakeSizedBackup(10, 100, 2, BackupStatusCreated),
+					fakeSizedBackup(11, 100, 4, BackupStatusCreated),
+					fakeSizedBackup(12, 101, 8, BackupStatusFailed),
+				},
+			})
+
+		case "/backup_nodes/2/backups":
+			writeJSON(t, w, http.StatusOK, BackupsResponse{
+				Data: []Backup{
+					fakeSizedBackup(13, 102, 16, BackupStatusCreated),
+				},
+			})
+
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestBackupNodesService_Usage(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, backupNodesUsageTestHandler(t))
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).BackupNodes.Usage(context.Background(), 1)
+		require.NoError(t, err)
+		require.Equal(t, BackupNodeUsage{
+			BackupNode:   BackupNode{ID: 1},
+			BackupsCount: 3,
+			TotalSize:    7,
+			Servers:      map[int]float64{10: 3, 11: 4},
+			Users:        map[int]float64{100: 7},
+		}, actual)
+	})
+
+	t.Run("failed to fetch next page", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/backup_nodes/1":
+				writeResponse(t, w, http.StatusOK, BackupNode{ID: 1})
+
+			case r.URL.Path == "/backup_nodes/1/backups" && r.URL.Query().Get("page") == "":
+				writeJSON(t, w, http.StatusOK, BackupsResponse{
+					paginatedResponse: paginatedResponse{
+						Links: ResponseLinks{Next: r.URL.Path + "?page=2"},
+						Meta:  ResponseMeta{CurrentPage: 1, LastPage: 2},
+					},
+					Data: []Backup{fakeSizedBackup(10, 100, 1, BackupStatusCreated)},
+				})
+
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).BackupNodes.Usage(context.Background(), 1)
+		require.Error(t, err)
+		require.Equal(t, BackupNodeUsage{}, actual)
+	})
+}
+
+func TestBackupNodesService_UsageReport(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, backupNodesUsageTestHandler(t))
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).BackupNodes.UsageReport(context.Background())
+		require.NoError(t, err)
+		require.Len(t, actual, 2)
+		assert.Equal(t, 2, actual[0].BackupNode.ID)
+		assert.Equal(t, float64(16), actual[0].TotalSize)
+		assert.Equal(t, 1, actual[1].BackupNode.ID)
+		assert.Equal(t, float64(7), actual[1].TotalSize)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/backup_nodes" {
+				writeJSON(t, w, http.StatusOK, BackupNodesResponse{
+					Data: []BackupNode{{ID: 1}},
+				})
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		defer s.Close()
+
+		_, err := createTestClient(t, s.URL).BackupNodes.UsageReport(context.Background())
+		require.EqualError(
+			t,
+			err,
+			"calculate usage of backup node 1: HTTP GET backup_nodes/1/backups returns 400 status code",
+		)
+	})
+}
@@ -9,40 +9,22 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestBackupNodeSSHRsyncCredentials(t *testing.T) {
-	c := BackupNodeSSHRsyncCredentials("example.com", 22, "root", "private key", "/foo/bar")
-	assert.Equal(t, map[string]interface{}{
-		"host":         "example.com",
-		"port":         22,
-		"login":        "root",
-		"key":          "private key",
-		"storage_path": "/foo/bar",
-	}, c)
-}
-
-func TestBackupNodeHetznerStorageBoxCredentials(t *testing.T) {
-	c := BackupNodeHetznerStorageBoxCredentials("example.com", "root", "private key")
-	assert.Equal(t, map[string]interface{}{
-		"host":  "example.com",
-		"login": "root",
-		"key":   "private key",
-	}, c)
-}
-
 func TestBackupNodesService_Create(t *testing.T) {
 	data := BackupNodeRequest{
 		Name:             "name",
-		Type:             BackupNodeTypeSSHRsync,
+		Type:             BackupNodeTypeHetznerStorageBox,
 		ComputeResources: []int{1, 2},
-		Credentials: map[string]interface{}{
-			"foo": "bar",
+		Credentials: BackupNodeCredentialsHetznerStorageBox{
+			Host:  "example.com",
+			Login: "root",
+			Key:   "private key",
 		},
 	}
 
 	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/backup_nodes", r.URL.Path)
 		assert.Equal(t, http.MethodPost, r.Method)
-		assertRequestBody(t, r, data)
+		assertRequestJSON(t, r, data)
 
 		writeResponse(t, w, http.StatusCreated, fakeBackupNode)
 	})
@@ -56,17 +38,19 @@ func TestBackupNodesService_Create(t *testing.T) {
 func TestBackupNodesService_Update(t *testing.T) {
 	data := BackupNodeRequest{
 		Name:             "name",
-		Type:             BackupNodeTypeSSHRsync,
+		Type:             BackupNodeTypeHetznerStorageBox,
 		ComputeResources: []int{1, 2},
-		Credentials: map[string]interface{}{
-			"foo": "bar",
+		Credentials: BackupNodeCredentialsHetznerStorageBox{
+			Host:  "example.com",
+			Login: "root",
+			Key:   "private key",
 		},
 	}
 
 	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/backup_nodes/10", r.URL.Path)
 		assert.Equal(t, http.MethodPut, r.Method)
-		assertRequestBody(t, r, data)
+		assertRequestJSON(t, r, data)
 
 		writeResponse(t, w, http.StatusOK, fakeBackupNode)
 	})
@@ -89,3 +73,59 @@ func TestBackupNodesService_Delete(t *testing.T) {
 	err := createTestClient(t, s.URL).BackupNodes.Delete(context.Background(), 10)
 	require.NoError(t, err)
 }
+
+func TestBackupNodesService_List(t *testing.T) {
+	expected := BackupNodesResponse{
+		Data: []BackupNode{
+			fakeBackupNode,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/backup_nodes", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).BackupNodes.List(context.Background())
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
+func TestBackupNodesService_Get(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/backup_nodes/10", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeBackupNode)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).BackupNodes.Get(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeBackupNode, actual)
+}
+
+func TestBackupNodesService_Backups(t *testing.T) {
+	expected := BackupsResponse{
+		Data: []Backup{
+			fakeBackup,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/backup_nodes/10/backups", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).BackupNodes.Backups(context.Background(), 10)
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
@@ -3,6 +3,7 @@ package solus
 import (
 	"context"
 	"fmt"
+	"net/http"
 )
 
 // BackupsService handles all available methods with backups.
@@ -72,10 +73,28 @@ func (b Backup) IsFinished() bool {
 		b.Status == BackupStatusFailed
 }
 
+// BackupsResponse represents paginated list of backups.
+// This cursor can be used for iterating over all available backups.
+type BackupsResponse struct {
+	paginatedResponse
+
+	Data []Backup `json:"data"`
+}
+
 type backupResponse struct {
 	Data Backup `json:"data"`
 }
 
+// List lists backups.
+func (s *BackupsService) List(ctx context.Context, filter *FilterBackups) (BackupsResponse, error) {
+	resp := BackupsResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, "backups", &resp, withFilter(filter.data))
+}
+
 // Get gets specified backup.
 func (s *BackupsService) Get(ctx context.Context, id int) (Backup, error) {
 	var resp backupResponse
@@ -91,3 +110,46 @@ func (s *BackupsService) Delete(ctx context.Context, id int) error {
 func (s *BackupsService) Restore(ctx context.Context, id int) (Task, error) {
 	return s.client.asyncPost(ctx, fmt.Sprintf("backups/%d/restore", id))
 }
+
+// Cancel cancels specified pending or in progress backup.
+func (s *BackupsService) Cancel(ctx context.Context, id int) error {
+	path := fmt.Sprintf("backups/%d/cancel", id)
+	body, code, err := s.client.request(ctx, http.MethodPost, path)
+	if err != nil {
+		return err
+	}
+
+	if code != http.StatusOK {
+		return newHTTPError(http.MethodPost, path, code, body)
+	}
+	return nil
+}
+
+// Wait waits until specified backup is finished. The optional onProgress
+// callback is called with the backup on every poll, so the caller may track
+// BackupProgress. Returns an error with the backup fail reason if the backup
+// is failed.
+func (s *BackupsService) Wait(ctx context.Context, id int, onProgress func(Backup)) (Backup, error) {
+	var backup Backup
+	err := s.client.poll(ctx, func(ctx context.Context) (bool, error) {
+		var err error
+		backup, err = s.Get(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		if onProgress != nil {
+			onProgress(backup)
+		}
+
+		if !backup.IsFinished() {
+			return false, nil
+		}
+
+		if backup.Status == BackupStatusFailed {
+			return false, fmt.Errorf("backup %d is failed: %s", id, backup.BackupFailReason)
+		}
+		return true, nil
+	})
+	return backup, err
+}
@@ -0,0 +1,42 @@
+package solus
+
+// FilterBackups represent available filters for fetching list of backups.
+type FilterBackups struct {
+	filter
+}
+
+// ByVirtualServerID filter backups by specified virtual server ID.
+func (f *FilterBackups) ByVirtualServerID(id int) *FilterBackups {
+	f.addInt("filter[compute_resource_vm_id]", id)
+	return f
+}
+
+// ByComputeResourceID filter backups by specified compute resource ID.
+func (f *FilterBackups) ByComputeResourceID(id int) *FilterBackups {
+	f.addInt("filter[compute_resource_id]", id)
+	return f
+}
+
+// ByBackupNodeID filter backups by specified backup node ID.
+func (f *FilterBackups) ByBackupNodeID(id int) *FilterBackups {
+	f.addInt("filter[backup_node_id]", id)
+	return f
+}
+
+// ByStatus filter backups by specified status.
+func (f *FilterBackups) ByStatus(status BackupStatus) *FilterBackups {
+	f.add("filter[status]", string(status))
+	return f
+}
+
+// ByType filter backups by specified type.
+func (f *FilterBackups) ByType(typ BackupType) *FilterBackups {
+	f.add("filter[type]", string(typ))
+	return f
+}
+
+// ByCreationMethod filter backups by specified creation method.
+func (f *FilterBackups) ByCreationMethod(method BackupCreationMethod) *FilterBackups {
+	f.add("filter[creation_method]", string(method))
+	return f
+}
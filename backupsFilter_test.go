@@ -0,0 +1,28 @@
+package solus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterBackups(t *testing.T) {
+	f := FilterBackups{}
+
+	f.
+		ByVirtualServerID(1).
+		ByComputeResourceID(2).
+		ByBackupNodeID(3).
+		ByStatus(BackupStatusInProgress).
+		ByType(BackupTypeIncremental).
+		ByCreationMethod(BackupCreationMethodManual)
+
+	require.Equal(t, map[string]string{
+		"filter[compute_resource_vm_id]": "1",
+		"filter[compute_resource_id]":    "2",
+		"filter[backup_node_id]":         "3",
+		"filter[status]":                 string(BackupStatusInProgress),
+		"filter[type]":                   string(BackupTypeIncremental),
+		"filter[creation_method]":        string(BackupCreationMethodManual),
+	}, f.data)
+}
@@ -3,6 +3,8 @@ package solus
 import (
 	"context"
 	"net/http"
+	"net/url"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,6 +28,35 @@ func TestBackup_IsFinished(t *testing.T) {
 	}
 }
 
+func TestBackupsService_List(t *testing.T) {
+	expected := BackupsResponse{
+		Data: []Backup{
+			fakeBackup,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/backups", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assertRequestQuery(t, r, url.Values{
+			"filter[compute_resource_vm_id]": []string{"1"},
+			"filter[status]":                 []string{string(BackupStatusCreated)},
+		})
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	f := (&FilterBackups{}).
+		ByVirtualServerID(1).
+		ByStatus(BackupStatusCreated)
+
+	actual, err := createTestClient(t, s.URL).Backups.List(context.Background(), f)
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
 func TestBackupsService_Get(t *testing.T) {
 	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/backups/10", r.URL.Path)
@@ -81,3 +112,87 @@ func TestBackupsService_Restore(t *testing.T) {
 		assert.EqualError(t, err, "HTTP POST backups/10/restore returns 404 status code")
 	})
 }
+
+func TestBackupsService_Cancel(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/backups/10/cancel", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			w.WriteHeader(http.StatusOK)
+		})
+		defer s.Close()
+
+		err := createTestClient(t, s.URL).Backups.Cancel(context.Background(), 10)
+		require.NoError(t, err)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to make request", func(t *testing.T) {
+			asserter, addr := startBrokenTestServer(t)
+
+			err := createTestClient(t, addr).Backups.Cancel(context.Background(), 10)
+			asserter(t, http.MethodPost, "/backups/10/cancel", err)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			err := createTestClient(t, s.URL).Backups.Cancel(context.Background(), 10)
+			assert.EqualError(t, err, "HTTP POST backups/10/cancel returns 400 status code")
+		})
+	})
+}
+
+func TestBackupsService_Wait(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		calls := int32(0)
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/backups/10", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			backup := fakeBackup
+			if n := atomic.AddInt32(&calls, 1); n < 3 {
+				backup.Status = BackupStatusInProgress
+				backup.BackupProgress = float32(n * 30)
+			}
+			writeResponse(t, w, http.StatusOK, backup)
+		})
+		defer s.Close()
+
+		var progress []float32
+		actual, err := createTestClient(t, s.URL).Backups.Wait(context.Background(), 10, func(b Backup) {
+			progress = append(progress, b.BackupProgress)
+		})
+		require.NoError(t, err)
+		require.Equal(t, fakeBackup, actual)
+		require.Equal(t, []float32{30, 60, 90}, progress)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed backup", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				backup := fakeBackup
+				backup.Status = BackupStatusFailed
+				writeResponse(t, w, http.StatusOK, backup)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Backups.Wait(context.Background(), 10, nil)
+			require.EqualError(t, err, "backup 10 is failed: for some reason")
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Backups.Wait(context.Background(), 10, nil)
+			require.EqualError(t, err, "HTTP GET backups/10 returns 400 status code")
+		})
+	})
+}
@@ -8,9 +8,13 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 )
 
+// defaultPollInterval a default interval between requests made by Wait* helpers.
+const defaultPollInterval = 5 * time.Second
+
 // Client a Solus API client.
 type Client struct {
 	BaseURL     *url.URL
@@ -22,9 +26,25 @@ type Client struct {
 	Retries     int
 	RetryAfter  time.Duration
 
+	// PollInterval an interval between requests made by Wait* helpers. The
+	// default interval is used if it isn't positive.
+	PollInterval time.Duration
+
 	s service
 
+	// credentialsMu guards Credentials and Authorization header which may be
+	// replaced by SetCredentials while requests are in flight.
+	credentialsMu sync.RWMutex
+
+	// authenticator is used for lazy authentication on the first request. It's
+	// reset to nil once the client is authenticated.
+	authenticator  Authenticator
+	lazyAuth       bool
+	authenticateMu sync.Mutex
+
+	APITokens         *APITokensService
 	Account           *AccountService
+	Auth              *AuthService
 	ActivityLogs      *ActivityLogsService
 	Applications      *ApplicationsService
 	BackupNodes       *BackupNodesService
@@ -33,12 +53,14 @@ type Client struct {
 	IPBlocks          *IPBlocksService
 	Icons             *IconsService
 	License           *LicenseService
+	LimitGroups       *LimitGroupsService
 	Locations         *LocationsService
 	OsImageVersions   *OsImageVersionsService
 	OsImages          *OsImagesService
 	Permission        *PermissionsService
 	Plans             *PlansService
 	Projects          *ProjectsService
+	ReverseDNS        *ReverseDNSService
 	Roles             *RolesService
 	SSHKeys           *SSHKeysService
 	ServersMigrations *ServersMigrationsService
@@ -75,11 +97,18 @@ var _ Authenticator = EmailAndPasswordAuthenticator{}
 
 // Authenticate authenticates by email and password.
 func (a EmailAndPasswordAuthenticator) Authenticate(c *Client) (Credentials, error) {
-	resp, err := c.authLogin(context.Background(), AuthLoginRequest(a))
+	resp, err := c.authLogin(context.Background(), AuthLoginRequest{
+		Email:    a.Email,
+		Password: a.Password,
+	})
 	if err != nil {
 		return Credentials{}, err
 	}
 
+	if resp.TwoFactorAuthRequired {
+		return Credentials{}, errTwoFactorAuthRequired
+	}
+
 	return resp.Credentials, nil
 }
 
@@ -117,6 +146,23 @@ func SetRetryPolicy(retries int, retryAfter time.Duration) ClientOption {
 	}
 }
 
+// SetPollInterval sets an interval between requests made by Wait* helpers.
+// The default interval is used if it isn't positive.
+func SetPollInterval(interval time.Duration) ClientOption {
+	return func(c *Client) {
+		c.PollInterval = interval
+	}
+}
+
+// WithLazyAuthentication defers authentication until the first request which
+// requires it. NewClient doesn't make any requests in that case, so the client
+// can be created even if the panel is unreachable.
+func WithLazyAuthentication() ClientOption {
+	return func(c *Client) {
+		c.lazyAuth = true
+	}
+}
+
 // WithLogger inject specific logger into client.
 func WithLogger(logger Logger) ClientOption {
 	return func(c *Client) {
@@ -130,6 +176,24 @@ func NewClient(
 	a Authenticator,
 	opts ...ClientOption,
 ) (*Client, error) {
+	client := newClient(baseURL, opts...)
+
+	if client.lazyAuth {
+		client.authenticator = a
+		return client, nil
+	}
+
+	c, err := a.Authenticate(client)
+	if err != nil {
+		return nil, fmt.Errorf("authenticate: %w", err)
+	}
+
+	client.SetCredentials(c)
+	return client, nil
+}
+
+// newClient creates Client instance without authentication.
+func newClient(baseURL *url.URL, opts ...ClientOption) *Client {
 	client := &Client{
 		BaseURL:   baseURL,
 		UserAgent: "Go SDK client",
@@ -141,26 +205,21 @@ func NewClient(
 			Timeout:   time.Second * 35,
 			Transport: http.DefaultTransport.(*http.Transport).Clone(),
 		},
-		Logger:     NullLogger{},
-		Retries:    5,
-		RetryAfter: 1 * time.Second,
+		Logger:       NullLogger{},
+		Retries:      5,
+		RetryAfter:   1 * time.Second,
+		PollInterval: defaultPollInterval,
 	}
 
 	for _, o := range opts {
 		o(client)
 	}
 
-	c, err := a.Authenticate(client)
-	if err != nil {
-		return nil, fmt.Errorf("authenticate: %w", err)
-	}
-
-	client.Credentials = c
-	client.Headers["Authorization"] = []string{client.Credentials.TokenType + " " + client.Credentials.AccessToken}
-
 	client.s.client = client
 
+	client.APITokens = (*APITokensService)(&client.s)
 	client.Account = (*AccountService)(&client.s)
+	client.Auth = (*AuthService)(&client.s)
 	client.ActivityLogs = (*ActivityLogsService)(&client.s)
 	client.Applications = (*ApplicationsService)(&client.s)
 	client.BackupNodes = (*BackupNodesService)(&client.s)
@@ -169,12 +228,14 @@ func NewClient(
 	client.IPBlocks = (*IPBlocksService)(&client.s)
 	client.Icons = (*IconsService)(&client.s)
 	client.License = (*LicenseService)(&client.s)
+	client.LimitGroups = (*LimitGroupsService)(&client.s)
 	client.Locations = (*LocationsService)(&client.s)
 	client.OsImageVersions = (*OsImageVersionsService)(&client.s)
 	client.OsImages = (*OsImagesService)(&client.s)
 	client.Permission = (*PermissionsService)(&client.s)
 	client.Plans = (*PlansService)(&client.s)
 	client.Projects = (*ProjectsService)(&client.s)
+	client.ReverseDNS = (*ReverseDNSService)(&client.s)
 	client.Roles = (*RolesService)(&client.s)
 	client.SSHKeys = (*SSHKeysService)(&client.s)
 	client.ServersMigrations = (*ServersMigrationsService)(&client.s)
@@ -186,12 +247,47 @@ func NewClient(
 	client.Users = (*UsersService)(&client.s)
 	client.VirtualServers = (*VirtualServersService)(&client.s)
 
-	return client, nil
+	return client
+}
+
+// SetCredentials replaces credentials used for making API calls. It's safe to
+// call it while other requests are in flight.
+func (c *Client) SetCredentials(credentials Credentials) {
+	c.credentialsMu.Lock()
+	defer c.credentialsMu.Unlock()
+
+	c.Credentials = credentials
+	c.Headers["Authorization"] = []string{authorizationHeader(credentials)}
+}
+
+// authenticateLazily authenticates the client if it's created with lazy
+// authentication and isn't authenticated yet. Failed authentication is retried
+// on the next request.
+func (c *Client) authenticateLazily() error {
+	c.authenticateMu.Lock()
+	defer c.authenticateMu.Unlock()
+
+	if c.authenticator == nil {
+		return nil
+	}
+
+	creds, err := c.authenticator.Authenticate(c)
+	if err != nil {
+		return fmt.Errorf("authenticate: %w", err)
+	}
+
+	c.SetCredentials(creds)
+	c.authenticator = nil
+	return nil
+}
+
+func authorizationHeader(c Credentials) string {
+	return c.TokenType + " " + c.AccessToken
 }
 
 func (c *Client) authLogin(ctx context.Context, data AuthLoginRequest) (AuthLoginResponse, error) {
 	const path = "auth/login"
-	body, code, err := c.request(ctx, http.MethodPost, path, withBody(data))
+	body, code, err := c.request(ctx, http.MethodPost, path, withBody(data), withoutAuthentication())
 	if err != nil {
 		return AuthLoginResponse{}, err
 	}
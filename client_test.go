@@ -1,6 +1,8 @@
 package solus
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -31,6 +33,14 @@ func TestSetRetryPolicy(t *testing.T) {
 	assert.Equal(t, time.Second, c.RetryAfter)
 }
 
+func TestSetPollInterval(t *testing.T) {
+	c := &Client{}
+
+	SetPollInterval(time.Second)(c)
+
+	assert.Equal(t, time.Second, c.PollInterval)
+}
+
 type fakeLogger struct{}
 
 func (fakeLogger) Debugf(string, ...interface{}) {}
@@ -116,3 +126,103 @@ func TestAPITokenAuthenticator_Authenticate(t *testing.T) {
 		ExpiresAt:   "",
 	}, c.Credentials)
 }
+
+func TestClient_SetCredentials(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer new", r.Header.Get("Authorization"))
+
+		writeResponse(t, w, http.StatusOK, fakeUser)
+	})
+	defer s.Close()
+
+	credentials := Credentials{
+		AccessToken: "new",
+		TokenType:   "Bearer",
+	}
+
+	c := createTestClient(t, s.URL)
+	c.SetCredentials(credentials)
+	require.Equal(t, credentials, c.Credentials)
+
+	_, err := c.Account.Get(context.Background())
+	require.NoError(t, err)
+}
+
+func TestWithLazyAuthentication(t *testing.T) {
+	credentials := Credentials{
+		AccessToken: "token",
+		TokenType:   "Bearer",
+	}
+
+	newLazyClient := func(t *testing.T, addr string, a Authenticator) *Client {
+		t.Helper()
+
+		u, err := url.Parse(addr)
+		require.NoError(t, err)
+
+		c, err := NewClient(u, a, WithLazyAuthentication(), SetRetryPolicy(0, 0))
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/health":
+				assert.Empty(t, r.Header.Get("Authorization"))
+				writeResponse(t, w, http.StatusOK, Health{Status: "ok"})
+
+			case "/account":
+				assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				writeResponse(t, w, http.StatusOK, fakeUser)
+
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		defer s.Close()
+
+		calls := 0
+		c := newLazyClient(t, s.URL, fakeAuthenticator{credentials: credentials, calls: &calls})
+		require.Equal(t, 0, calls)
+
+		require.NoError(t, c.Ping(context.Background()))
+		require.Equal(t, 0, calls)
+
+		for i := 0; i < 2; i++ {
+			_, err := c.Account.Get(context.Background())
+			require.NoError(t, err)
+		}
+		require.Equal(t, 1, calls)
+		require.Equal(t, credentials, c.Credentials)
+	})
+
+	t.Run("unreachable panel", func(t *testing.T) {
+		_, addr := startBrokenTestServer(t)
+
+		c := newLazyClient(t, addr, EmailAndPasswordAuthenticator{
+			Email:    "test@example.com",
+			Password: "Pass80rd",
+		})
+
+		_, err := c.Account.Get(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `authenticate: Post "`+addr+`/auth/login"`)
+	})
+
+	t.Run("failed authentication is retried", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			writeResponse(t, w, http.StatusOK, fakeUser)
+		})
+		defer s.Close()
+
+		calls := 0
+		c := newLazyClient(t, s.URL, fakeAuthenticator{err: errors.New("fake error"), calls: &calls})
+
+		for i := 0; i < 2; i++ {
+			_, err := c.Account.Get(context.Background())
+			require.EqualError(t, err, "authenticate: fake error")
+		}
+		require.Equal(t, 2, calls)
+	})
+}
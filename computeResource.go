@@ -389,3 +389,35 @@ func (s *ComputeResourcesService) ThinPools(ctx context.Context, id int) ([]Comp
 	}
 	return resp.Data, s.client.get(ctx, fmt.Sprintf("compute_resources/%d/thin_pools", id), &resp)
 }
+
+// Upgrade upgrades the agent on the specified compute resource.
+func (s *ComputeResourcesService) Upgrade(ctx context.Context, id int) (Task, error) {
+	return s.client.asyncPost(ctx, fmt.Sprintf("compute_resources/%d/upgrade", id))
+}
+
+// ClearImageCache clears OS images cache on the specified compute resource.
+func (s *ComputeResourcesService) ClearImageCache(ctx context.Context, id int) (Task, error) {
+	return s.client.asyncPost(ctx, fmt.Sprintf("compute_resources/%d/clear_cache", id))
+}
+
+// UpdateNetworkRules updates network rules on the specified compute resource.
+func (s *ComputeResourcesService) UpdateNetworkRules(ctx context.Context, id int) (Task, error) {
+	return s.client.asyncPost(ctx, fmt.Sprintf("compute_resources/%d/update_network_rules", id))
+}
+
+// RetryInstall re-runs installation of the agent on the specified compute
+// resource. The installation progress may be tracked by WaitInstalled.
+func (s *ComputeResourcesService) RetryInstall(ctx context.Context, id int) (ComputeResource, error) {
+	path := fmt.Sprintf("compute_resources/%d/retry_install", id)
+	body, code, err := s.client.request(ctx, http.MethodPost, path)
+	if err != nil {
+		return ComputeResource{}, err
+	}
+
+	if code != http.StatusOK {
+		return ComputeResource{}, newHTTPError(http.MethodPost, path, code, body)
+	}
+
+	var resp computeResourceResponse
+	return resp.Data, unmarshal(body, &resp)
+}
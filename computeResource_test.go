@@ -323,3 +323,79 @@ func TestComputeResourcesService_ThinPools(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expected, actual)
 }
+
+func TestComputeResourcesService_Upgrade(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/compute_resources/10/upgrade", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeTask)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ComputeResources.Upgrade(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeTask, actual)
+}
+
+func TestComputeResourcesService_ClearImageCache(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/compute_resources/10/clear_cache", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeTask)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ComputeResources.ClearImageCache(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeTask, actual)
+}
+
+func TestComputeResourcesService_UpdateNetworkRules(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/compute_resources/10/update_network_rules", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeTask)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ComputeResources.UpdateNetworkRules(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeTask, actual)
+}
+
+func TestComputeResourcesService_RetryInstall(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/compute_resources/10/retry_install", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			writeResponse(t, w, http.StatusOK, fakeComputeResource)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).ComputeResources.RetryInstall(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, fakeComputeResource, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to make request", func(t *testing.T) {
+			asserter, addr := startBrokenTestServer(t)
+			_, err := createTestClient(t, addr).ComputeResources.RetryInstall(context.Background(), 10)
+			asserter(t, http.MethodPost, "/compute_resources/10/retry_install", err)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).ComputeResources.RetryInstall(context.Background(), 10)
+			assert.EqualError(t, err, "HTTP POST compute_resources/10/retry_install returns 400 status code")
+		})
+	})
+}
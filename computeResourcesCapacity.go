@@ -0,0 +1,210 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// ComputeResourceCapacityItem represents capacity of a single compute resource.
+type ComputeResourceCapacityItem struct {
+	ComputeResource ComputeResource
+	Storages        []Storage
+}
+
+// StorageFreeSpace returns total free space of the compute resource's storages
+// which are available for balancing.
+func (i ComputeResourceCapacityItem) StorageFreeSpace() float64 {
+	var free float64
+	for _, s := range i.Storages {
+		if s.IsAvailableForBalancing {
+			free += s.FreeSpace
+		}
+	}
+	return free
+}
+
+// fits checks the plan fits into the compute resource.
+func (i ComputeResourceCapacityItem) fits(plan Plan) bool {
+	if i.ComputeResource.Status != ComputeResourceStatusActive || i.ComputeResource.IsLocked {
+		return false
+	}
+
+	vs := VirtualServer{
+		VirtualizationType: plan.VirtualizationType,
+		Specifications: VirtualServerSpecifications{
+			Disk: plan.Params.Disk,
+			RAM:  plan.Params.RAM,
+			VCPU: plan.Params.VCPU,
+		},
+	}
+	if !newComputeResourceCapacity(i.ComputeResource).fits(vs) {
+		return false
+	}
+
+	for _, s := range i.Storages {
+		if !s.IsAvailableForBalancing {
+			continue
+		}
+		if plan.StorageType != "" && string(s.Type.Name) != plan.StorageType {
+			continue
+		}
+		if s.FreeSpace >= float64(plan.Params.Disk) {
+			return true
+		}
+	}
+	return false
+}
+
+// ComputeResourceCapacitySummary represents aggregated capacity of many compute
+// resources. A limit is unlimited if it's unlimited on any of the compute
+// resources.
+type ComputeResourceCapacitySummary struct {
+	ComputeResources int
+	VM               ComputeResourceSettingsLimit
+	HDD              ComputeResourceSettingsLimit
+	RAM              ComputeResourceSettingsLimit
+	VCPU             ComputeResourceSettingsLimit
+	StorageFreeSpace float64
+}
+
+func (s *ComputeResourceCapacitySummary) add(i ComputeResourceCapacityItem) {
+	l := i.ComputeResource.Settings.Limits
+	s.ComputeResources++
+	addCapacityLimit(&s.VM, l.VM)
+	addCapacityLimit(&s.HDD, l.HDD)
+	addCapacityLimit(&s.RAM, l.RAM)
+	addCapacityLimit(&s.VCPU, l.VCPU)
+	s.StorageFreeSpace += i.StorageFreeSpace()
+}
+
+func addCapacityLimit(dst *ComputeResourceSettingsLimit, l ComputeResourceSettingsLimit) {
+	dst.Unlimited = dst.Unlimited || l.Unlimited
+	dst.Total += l.Total
+	dst.Used += l.Used
+}
+
+// ComputeResourceCapacityReport represents capacity of compute resources.
+type ComputeResourceCapacityReport struct {
+	ComputeResources []ComputeResourceCapacityItem
+}
+
+// Summary aggregates capacity of all compute resources in the report.
+func (r ComputeResourceCapacityReport) Summary() ComputeResourceCapacitySummary {
+	var s ComputeResourceCapacitySummary
+	for _, i := range r.ComputeResources {
+		s.add(i)
+	}
+	return s
+}
+
+// ByLocation aggregates capacity of compute resources grouped by location ID.
+// A compute resource which belongs to many locations is counted in each of them.
+func (r ComputeResourceCapacityReport) ByLocation() map[int]ComputeResourceCapacitySummary {
+	res := map[int]ComputeResourceCapacitySummary{}
+	for _, i := range r.ComputeResources {
+		for _, l := range i.ComputeResource.Locations {
+			s := res[l.ID]
+			s.add(i)
+			res[l.ID] = s
+		}
+	}
+	return res
+}
+
+// InLocation returns a report which contains only compute resources belong
+// to the specified location.
+func (r ComputeResourceCapacityReport) InLocation(locationID int) ComputeResourceCapacityReport {
+	var res ComputeResourceCapacityReport
+	for _, i := range r.ComputeResources {
+		for _, l := range i.ComputeResource.Locations {
+			if l.ID == locationID {
+				res.ComputeResources = append(res.ComputeResources, i)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// Fits returns compute resources which can fit a server created by the
+// specified plan.
+// Only active and not locked compute resources are considered. A compute
+// resource fits if it supports plan's virtualization type, has enough free VM,
+// disk, RAM and VCPU capacity and has a storage available for balancing of
+// plan's storage type with enough free space.
+// The result is ordered according to the strategy, so the first element is
+// the preferred one. For ComputeResourceBalanceStrategyMostStorageAvailable
+// compute resources with the most free storage space go first, for
+// ComputeResourceBalanceStrategyRoundRobin the ones with the least number of
+// servers go first, and ComputeResourceBalanceStrategyRandom shuffles them.
+// Otherwise, compute resources are ordered by ID.
+func (r ComputeResourceCapacityReport) Fits(
+	plan Plan,
+	strategy ComputeResourceBalanceStrategy,
+) []ComputeResourceCapacityItem {
+	var res []ComputeResourceCapacityItem
+	for _, i := range r.ComputeResources {
+		if i.fits(plan) {
+			res = append(res, i)
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].ComputeResource.ID < res[j].ComputeResource.ID
+	})
+
+	switch strategy {
+	case ComputeResourceBalanceStrategyMostStorageAvailable:
+		sort.SliceStable(res, func(i, j int) bool {
+			return res[i].StorageFreeSpace() > res[j].StorageFreeSpace()
+		})
+
+	case ComputeResourceBalanceStrategyRoundRobin:
+		sort.SliceStable(res, func(i, j int) bool {
+			return res[i].ComputeResource.VMsCount < res[j].ComputeResource.VMsCount
+		})
+
+	case ComputeResourceBalanceStrategyRandom:
+		rand.Shuffle(len(res), func(i, j int) {
+			res[i], res[j] = res[j], res[i]
+		})
+	}
+	return res
+}
+
+// CapacityReport builds a capacity report of all compute resources including
+// their storages.
+func (s *ComputeResourcesService) CapacityReport(ctx context.Context) (ComputeResourceCapacityReport, error) {
+	resp, err := s.List(ctx, &FilterComputeResources{})
+	if err != nil {
+		return ComputeResourceCapacityReport{}, err
+	}
+
+	var report ComputeResourceCapacityReport
+	for {
+		for _, cr := range resp.Data {
+			storages, err := s.StorageList(ctx, cr.ID)
+			if err != nil {
+				return ComputeResourceCapacityReport{}, fmt.Errorf(
+					"list storages of compute resource %d: %w",
+					cr.ID,
+					err,
+				)
+			}
+
+			report.ComputeResources = append(report.ComputeResources, ComputeResourceCapacityItem{
+				ComputeResource: cr,
+				Storages:        storages,
+			})
+		}
+		if !resp.Next(ctx) {
+			break
+		}
+	}
+	if resp.Err() != nil {
+		return ComputeResourceCapacityReport{}, resp.Err()
+	}
+	return report, nil
+}
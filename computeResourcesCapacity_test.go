@@ -0,0 +1,203 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeCapacityStorage(name StorageTypeName, free float64) Storage {
+	return Storage{
+		Type:                    StorageType{Name: name},
+		IsAvailableForBalancing: true,
+		FreeSpace:               free,
+	}
+}
+
+func TestComputeResourceCapacityReport_Summary(t *testing.T) {
+	cr1 := fakeCapacityComputeResource(1, 10, 100, 1000, 8)
+	cr1.Settings.Limits.RAM.Used = 500
+	cr1.Locations = []Location{{ID: 1}}
+
+	cr2 := fakeCapacityComputeResource(2, 10, 100, 1000, 8)
+	cr2.Settings.Limits.VCPU.Unlimited = true
+	cr2.Locations = []Location{{ID: 1}, {ID: 2}}
+
+	unavailable := fakeCapacityStorage(StorageTypeNameFB, 1000)
+	unavailable.IsAvailableForBalancing = false
+
+	r := ComputeResourceCapacityReport{
+		ComputeResources: []ComputeResourceCapacityItem{
+			{ComputeResource: cr1, Storages: []Storage{fakeCapacityStorage(StorageTypeNameFB, 10), unavailable}},
+			{ComputeResource: cr2, Storages: []Storage{fakeCapacityStorage(StorageTypeNameLVM, 20)}},
+		},
+	}
+
+	all := ComputeResourceCapacitySummary{
+		ComputeResources: 2,
+		VM:               ComputeResourceSettingsLimit{Total: 20},
+		HDD:              ComputeResourceSettingsLimit{Total: 200},
+		RAM:              ComputeResourceSettingsLimit{Total: 2000, Used: 500},
+		VCPU:             ComputeResourceSettingsLimit{Unlimited: true, Total: 16},
+		StorageFreeSpace: 30,
+	}
+	assert.Equal(t, all, r.Summary())
+
+	assert.Equal(t, map[int]ComputeResourceCapacitySummary{
+		1: all,
+		2: {
+			ComputeResources: 1,
+			VM:               ComputeResourceSettingsLimit{Total: 10},
+			HDD:              ComputeResourceSettingsLimit{Total: 100},
+			RAM:              ComputeResourceSettingsLimit{Total: 1000},
+			VCPU:             ComputeResourceSettingsLimit{Unlimited: true, Total: 8},
+			StorageFreeSpace: 20,
+		},
+	}, r.ByLocation())
+
+	require.Len(t, r.InLocation(2).ComputeResources, 1)
+	assert.Equal(t, 2, r.InLocation(2).ComputeResources[0].ComputeResource.ID)
+	assert.Empty(t, r.InLocation(3).ComputeResources)
+}
+
+func TestComputeResourceCapacityReport_Fits(t *testing.T) {
+	plan := Plan{
+		VirtualizationType: VirtualizationTypeKVM,
+		StorageType:        string(StorageTypeNameFB),
+		Params: PlanParams{
+			Disk: 10,
+			RAM:  10,
+			VCPU: 1,
+		},
+	}
+
+	item := func(id, vms int, free float64) ComputeResourceCapacityItem {
+		cr := fakeCapacityComputeResource(id, 100, 100, 100, 100)
+		cr.VMsCount = vms
+		return ComputeResourceCapacityItem{
+			ComputeResource: cr,
+			Storages:        []Storage{fakeCapacityStorage(StorageTypeNameFB, free)},
+		}
+	}
+
+	locked := item(10, 0, 100)
+	locked.ComputeResource.IsLocked = true
+
+	noRAM := item(11, 0, 100)
+	noRAM.ComputeResource.Settings.Limits.RAM.Used = 95
+
+	vz := item(12, 0, 100)
+	vz.ComputeResource.Settings.VirtualizationTypes = []VirtualizationType{VirtualizationTypeVZ}
+
+	lvm := item(13, 0, 100)
+	lvm.Storages[0].Type.Name = StorageTypeNameLVM
+
+	r := ComputeResourceCapacityReport{
+		ComputeResources: []ComputeResourceCapacityItem{
+			item(3, 1, 50),
+			item(1, 5, 20),
+			item(2, 3, 5),
+			item(4, 0, 100),
+			locked,
+			noRAM,
+			vz,
+			lvm,
+		},
+	}
+	r.ComputeResources[3].ComputeResource.Status = ComputeResourceStatusUnavailable
+
+	ids := func(ii []ComputeResourceCapacityItem) []int {
+		res := make([]int, 0, len(ii))
+		for _, i := range ii {
+			res = append(res, i.ComputeResource.ID)
+		}
+		return res
+	}
+
+	assert.Equal(t, []int{1, 3}, ids(r.Fits(plan, "")))
+	assert.Equal(t, []int{3, 1}, ids(r.Fits(plan, ComputeResourceBalanceStrategyMostStorageAvailable)))
+	assert.Equal(t, []int{3, 1}, ids(r.Fits(plan, ComputeResourceBalanceStrategyRoundRobin)))
+	assert.ElementsMatch(t, []int{1, 3}, ids(r.Fits(plan, ComputeResourceBalanceStrategyRandom)))
+
+	plan.StorageType = ""
+	assert.Equal(t, []int{1, 3, 13}, ids(r.Fits(plan, "")))
+}
+
+func TestComputeResourcesService_CapacityReport(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			switch r.URL.Path {
+			case "/compute_resources":
+				writeJSON(t, w, http.StatusOK, ComputeResourcesResponse{
+					Data: []ComputeResource{fakeComputeResource},
+				})
+
+			case "/compute_resources/1/storages":
+				writeResponse(t, w, http.StatusOK, []Storage{fakeStorage})
+
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).ComputeResources.CapacityReport(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, ComputeResourceCapacityReport{
+			ComputeResources: []ComputeResourceCapacityItem{
+				{ComputeResource: fakeComputeResource, Storages: []Storage{fakeStorage}},
+			},
+		}, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/compute_resources" {
+				writeJSON(t, w, http.StatusOK, ComputeResourcesResponse{
+					Data: []ComputeResource{fakeComputeResource},
+				})
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		defer s.Close()
+
+		_, err := createTestClient(t, s.URL).ComputeResources.CapacityReport(context.Background())
+		require.EqualError(
+			t,
+			err,
+			"list storages of compute resource 1: HTTP GET compute_resources/1/storages returns 400 status code",
+		)
+	})
+
+	t.Run("failed to fetch next page", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/compute_resources" && r.URL.Query().Get("page") == "":
+				writeJSON(t, w, http.StatusOK, ComputeResourcesResponse{
+					paginatedResponse: paginatedResponse{
+						Links: ResponseLinks{Next: r.URL.Path + "?page=2"},
+						Meta:  ResponseMeta{CurrentPage: 1, LastPage: 2},
+					},
+					Data: []ComputeResource{fakeComputeResource},
+				})
+
+			case r.URL.Path == "/compute_resources/1/storages":
+				writeResponse(t, w, http.StatusOK, []Storage{fakeStorage})
+
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).ComputeResources.CapacityReport(context.Background())
+		require.Error(t, err)
+		require.Equal(t, ComputeResourceCapacityReport{}, actual)
+	})
+}
@@ -0,0 +1,230 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// drainUnlockTimeout a timeout of unlocking a compute resource after a failed
+// drain.
+const drainUnlockTimeout = 30 * time.Second
+
+// ComputeResourceDrainOptions represents available options for draining a
+// compute resource.
+type ComputeResourceDrainOptions struct {
+	// DryRun indicates we should only plan the migration without locking the
+	// compute resource and migrating servers.
+	DryRun bool
+
+	// IsLive indicates servers should be migrated without stopping them.
+	// Otherwise, servers are stopped during migration.
+	IsLive bool
+
+	// PreserveIPs indicates servers should keep their IP addresses.
+	PreserveIPs bool
+
+	// OnMigration an optional callback which is called as soon as a server
+	// migration is finished. Calls are never concurrent.
+	OnMigration func(ServersMigrationResult)
+
+	// Maintenance an optional callback which is called as soon as the compute
+	// resource is drained. The compute resource is unlocked if the callback
+	// succeeds, otherwise it stays locked.
+	Maintenance func(ctx context.Context) error
+}
+
+// ComputeResourceDrainReport represents a result of draining a compute resource.
+type ComputeResourceDrainReport struct {
+	// Plan the first computed migration plan.
+	Plan       ServersMigrationPlan
+	Migrations []ServersMigration
+	Results    []ServersMigrationResult
+}
+
+type computeResourceLockRequest struct {
+	IsLocked bool `json:"is_locked"`
+}
+
+// Lock locks specified compute resource, so new servers won't be created on it.
+func (s *ComputeResourcesService) Lock(ctx context.Context, id int) (ComputeResource, error) {
+	var resp computeResourceResponse
+	return resp.Data, s.client.patch(
+		ctx,
+		fmt.Sprintf("compute_resources/%d", id),
+		computeResourceLockRequest{IsLocked: true},
+		&resp,
+	)
+}
+
+// Unlock unlocks specified compute resource.
+func (s *ComputeResourcesService) Unlock(ctx context.Context, id int) (ComputeResource, error) {
+	var resp computeResourceResponse
+	return resp.Data, s.client.patch(
+		ctx,
+		fmt.Sprintf("compute_resources/%d", id),
+		computeResourceLockRequest{IsLocked: false},
+		&resp,
+	)
+}
+
+// Drain locks specified compute resource, migrates all servers from it and
+// waits until there are no servers on it. Migrations to all destinations are
+// created at once and then waited for simultaneously.
+// The compute resource stays locked unless ComputeResourceDrainOptions.Maintenance
+// is specified, call Unlock after maintenance is finished. If the drain fails
+// before any migration is created the compute resource is unlocked.
+// The drain may be safely resumed by calling Drain again after an interruption:
+// servers which are being migrated already aren't migrated twice.
+// In dry-run mode only the migration plan is returned.
+func (s *ComputeResourcesService) Drain(
+	ctx context.Context,
+	id int,
+	opts ComputeResourceDrainOptions,
+) (ComputeResourceDrainReport, error) {
+	var report ComputeResourceDrainReport
+
+	if opts.DryRun {
+		plan, err := s.client.ServersMigrations.Plan(ctx, id)
+		report.Plan = plan
+		return report, err
+	}
+
+	if _, err := s.Lock(ctx, id); err != nil {
+		return report, fmt.Errorf("lock compute resource %d: %w", id, err)
+	}
+
+	planned := false
+	err := s.client.poll(ctx, func(ctx context.Context) (bool, error) {
+		cr, err := s.Get(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		if cr.VMsCount == 0 {
+			return true, nil
+		}
+
+		plan, err := s.client.ServersMigrations.Plan(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		if !planned {
+			report.Plan = plan
+			planned = true
+		}
+
+		if len(plan.Unplaced) > 0 {
+			return false, fmt.Errorf("servers %v don't fit into any compute resource", plan.Unplaced)
+		}
+
+		requests := plan.Requests(opts.IsLive, opts.PreserveIPs)
+		migrations := make([]ServersMigration, 0, len(requests))
+		for _, r := range requests {
+			m, err := s.client.ServersMigrations.Create(ctx, r)
+			if err != nil {
+				return false, fmt.Errorf(
+					"migrate servers to compute resource %d: %w",
+					r.DestinationComputeResourceID,
+					err,
+				)
+			}
+			migrations = append(migrations, m)
+			report.Migrations = append(report.Migrations, m)
+		}
+
+		results, err := s.waitMigrations(ctx, migrations, opts.OnMigration)
+		report.Results = append(report.Results, results...)
+		if err != nil {
+			return false, err
+		}
+
+		failed := 0
+		for _, res := range results {
+			if res.Err != nil {
+				failed++
+			}
+		}
+
+		if failed > 0 {
+			return false, fmt.Errorf("failed to migrate %d servers", failed)
+		}
+		return false, nil
+	})
+	if err != nil {
+		if len(report.Migrations) == 0 {
+			// Nothing is migrated, so the compute resource may be used as before.
+			// The drain's context may be done already, so it isn't used.
+			unlockCtx, cancel := context.WithTimeout(context.Background(), drainUnlockTimeout)
+			defer cancel()
+
+			if _, unlockErr := s.Unlock(unlockCtx, id); unlockErr != nil {
+				s.client.Logger.Errorf("failed to unlock compute resource %d: %s", id, unlockErr)
+			}
+		}
+		return report, err
+	}
+
+	if opts.Maintenance == nil {
+		return report, nil
+	}
+
+	if err := opts.Maintenance(ctx); err != nil {
+		return report, fmt.Errorf("maintenance of compute resource %d: %w", id, err)
+	}
+
+	if _, err := s.Unlock(ctx, id); err != nil {
+		return report, fmt.Errorf("unlock compute resource %d: %w", id, err)
+	}
+	return report, nil
+}
+
+// waitMigrations waits for specified migrations simultaneously. Returns
+// results in the same order as the migrations and the first occurred error.
+func (s *ComputeResourcesService) waitMigrations(
+	ctx context.Context,
+	migrations []ServersMigration,
+	onMigration func(ServersMigrationResult),
+) ([]ServersMigrationResult, error) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		results  = make([][]ServersMigrationResult, len(migrations))
+	)
+
+	onFinish := onMigration
+	if onMigration != nil {
+		onFinish = func(r ServersMigrationResult) {
+			mu.Lock()
+			defer mu.Unlock()
+			onMigration(r)
+		}
+	}
+
+	for i, m := range migrations {
+		wg.Add(1)
+		go func(i, id int) {
+			defer wg.Done()
+
+			res, err := s.client.ServersMigrations.Wait(ctx, id, onFinish)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			results[i] = res
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}(i, m.ID)
+	}
+	wg.Wait()
+
+	var all []ServersMigrationResult
+	for _, res := range results {
+		all = append(all, res...)
+	}
+	return all, firstErr
+}
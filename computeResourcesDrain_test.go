@@ -0,0 +1,384 @@
+package solus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeResourcesService_Lock(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/compute_resources/10", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assertRequestBody(t, r, map[string]bool{"is_locked": true})
+
+		writeResponse(t, w, http.StatusOK, fakeComputeResource)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ComputeResources.Lock(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeComputeResource, actual)
+}
+
+func TestComputeResourcesService_Unlock(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/compute_resources/10", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assertRequestBody(t, r, map[string]bool{"is_locked": false})
+
+		writeResponse(t, w, http.StatusOK, fakeComputeResource)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ComputeResources.Unlock(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeComputeResource, actual)
+}
+
+// drainTestServer emulates a compute resource with ID 1 which servers may be
+// migrated to a compute resource with ID 2, or to specified destinations.
+type drainTestServer struct {
+	mu           sync.Mutex
+	servers      []VirtualServer
+	destinations []ComputeResource
+	locked       bool
+	migrations   []ServersMigrationRequest
+	requests     []string
+	taskStatus   TaskStatus
+}
+
+func (d *drainTestServer) handle(t *testing.T, w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.requests = append(d.requests, r.Method+" "+r.URL.Path)
+
+	switch {
+	case r.Method == http.MethodPatch && r.URL.Path == "/compute_resources/1":
+		var req computeResourceLockRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		d.locked = req.IsLocked
+		writeResponse(t, w, http.StatusOK, ComputeResource{ID: 1, IsLocked: d.locked})
+
+	case r.Method == http.MethodGet && r.URL.Path == "/compute_resources/1":
+		writeResponse(t, w, http.StatusOK, ComputeResource{ID: 1, IsLocked: d.locked, VMsCount: len(d.servers)})
+
+	case r.Method == http.MethodGet && r.URL.Path == "/compute_resources":
+		destinations := d.destinations
+		if destinations == nil {
+			destinations = []ComputeResource{fakeCapacityComputeResource(2, 100, 100, 100, 100)}
+		}
+		writeJSON(t, w, http.StatusOK, ComputeResourcesResponse{
+			Data: append([]ComputeResource{fakeCapacityComputeResource(1, 100, 100, 100, 100)}, destinations...),
+		})
+
+	case r.Method == http.MethodGet && r.URL.Path == "/servers":
+		writeJSON(t, w, http.StatusOK, VirtualServersResponse{Data: d.servers})
+
+	case r.Method == http.MethodPost && r.URL.Path == "/servers_migrations":
+		var req ServersMigrationRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		d.migrations = append(d.migrations, req)
+		writeResponse(t, w, http.StatusCreated, ServersMigration{ID: 100 + len(d.migrations) - 1})
+
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/servers_migrations/"):
+		id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/servers_migrations/"))
+		require.NoError(t, err)
+
+		ids := d.migrations[id-100].Servers
+		children := make([]Task, 0, len(ids))
+		for _, id := range ids {
+			children = append(children, Task{ID: 200 + id, ComputeResourceVMID: id, Status: TaskStatusQueued})
+		}
+		writeResponse(t, w, http.StatusOK, ServersMigration{ID: id, Children: children})
+
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/tasks/"):
+		id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/tasks/"))
+		require.NoError(t, err)
+
+		serverID := id - 200
+		if d.taskStatus == TaskStatusDone {
+			for i, vs := range d.servers {
+				if vs.ID == serverID {
+					d.servers = append(d.servers[:i:i], d.servers[i+1:]...)
+					break
+				}
+			}
+		}
+		writeResponse(t, w, http.StatusOK, Task{ID: id, ComputeResourceVMID: serverID, Status: d.taskStatus})
+
+	default:
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func TestComputeResourcesService_Drain(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		d := &drainTestServer{
+			servers: []VirtualServer{
+				fakeSizedServer(1, 1, 1, 1),
+				{ID: 2, IsProcessing: true},
+			},
+			taskStatus: TaskStatusDone,
+		}
+
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			d.handle(t, w, r)
+
+			// The processing server finishes its migration on the second round.
+			d.mu.Lock()
+			if len(d.migrations) > 0 && len(d.servers) == 1 && r.URL.Path == "/compute_resources/1" {
+				d.servers = nil
+			}
+			d.mu.Unlock()
+		})
+		defer s.Close()
+
+		var finished []int
+		actual, err := createTestClient(t, s.URL).ComputeResources.Drain(
+			context.Background(),
+			1,
+			ComputeResourceDrainOptions{
+				IsLive:      true,
+				OnMigration: func(r ServersMigrationResult) { finished = append(finished, r.ServerID) },
+			},
+		)
+		require.NoError(t, err)
+
+		assert.True(t, d.locked)
+		assert.Equal(t, []ServersMigrationRequest{
+			{IsLive: true, DestinationComputeResourceID: 2, Servers: []int{1}},
+		}, d.migrations)
+		assert.Equal(t, ServersMigrationPlan{Destinations: map[int][]int{2: {1}}}, actual.Plan)
+		assert.Len(t, actual.Migrations, 1)
+		assert.Len(t, actual.Results, 1)
+		assert.Equal(t, []int{1}, finished)
+	})
+
+	t.Run("migrations are created at once", func(t *testing.T) {
+		d := &drainTestServer{
+			servers: []VirtualServer{
+				fakeSizedServer(1, 1, 1, 1),
+				fakeSizedServer(2, 1, 1, 1),
+			},
+			destinations: []ComputeResource{
+				fakeCapacityComputeResource(2, 1, 100, 100, 100),
+				fakeCapacityComputeResource(3, 1, 100, 100, 100),
+			},
+			taskStatus: TaskStatusDone,
+		}
+
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			d.handle(t, w, r)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).ComputeResources.Drain(
+			context.Background(),
+			1,
+			ComputeResourceDrainOptions{},
+		)
+		require.NoError(t, err)
+		require.Len(t, actual.Migrations, 2)
+		require.Len(t, actual.Results, 2)
+
+		var created, waited []int
+		for i, r := range d.requests {
+			switch {
+			case r == "POST /servers_migrations":
+				created = append(created, i)
+			case strings.HasPrefix(r, "GET /servers_migrations/"):
+				waited = append(waited, i)
+			}
+		}
+		require.Len(t, created, 2)
+		require.NotEmpty(t, waited)
+		assert.Less(t, created[1], waited[0])
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		d := &drainTestServer{
+			servers: []VirtualServer{
+				fakeSizedServer(1, 1, 1, 1),
+			},
+			taskStatus: TaskStatusDone,
+		}
+
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			d.handle(t, w, r)
+		})
+		defer s.Close()
+
+		var drained bool
+		_, err := createTestClient(t, s.URL).ComputeResources.Drain(
+			context.Background(),
+			1,
+			ComputeResourceDrainOptions{
+				Maintenance: func(context.Context) error {
+					d.mu.Lock()
+					defer d.mu.Unlock()
+
+					drained = d.locked && len(d.servers) == 0
+					return nil
+				},
+			},
+		)
+		require.NoError(t, err)
+		assert.True(t, drained)
+		assert.False(t, d.locked)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		d := &drainTestServer{
+			servers: []VirtualServer{
+				fakeSizedServer(1, 1, 1, 1),
+			},
+		}
+
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			d.handle(t, w, r)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).ComputeResources.Drain(
+			context.Background(),
+			1,
+			ComputeResourceDrainOptions{DryRun: true},
+		)
+		require.NoError(t, err)
+		assert.False(t, d.locked)
+		assert.Empty(t, d.migrations)
+		assert.Equal(t, ComputeResourceDrainReport{
+			Plan: ServersMigrationPlan{Destinations: map[int][]int{2: {1}}},
+		}, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to lock", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).ComputeResources.Drain(
+				context.Background(),
+				1,
+				ComputeResourceDrainOptions{},
+			)
+			require.EqualError(
+				t,
+				err,
+				"lock compute resource 1: HTTP PATCH compute_resources/1 returns 400 status code",
+			)
+		})
+
+		t.Run("unplaced servers", func(t *testing.T) {
+			d := &drainTestServer{
+				servers: []VirtualServer{
+					fakeSizedServer(1, 1000, 1, 1),
+				},
+			}
+
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				d.handle(t, w, r)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).ComputeResources.Drain(
+				context.Background(),
+				1,
+				ComputeResourceDrainOptions{},
+			)
+			require.EqualError(t, err, "servers [1] don't fit into any compute resource")
+			assert.Empty(t, d.migrations)
+			assert.False(t, d.locked)
+		})
+
+		t.Run("failed to create migration", func(t *testing.T) {
+			d := &drainTestServer{
+				servers: []VirtualServer{
+					fakeSizedServer(1, 1, 1, 1),
+				},
+			}
+
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost && r.URL.Path == "/servers_migrations" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				d.handle(t, w, r)
+			})
+			defer s.Close()
+
+			actual, err := createTestClient(t, s.URL).ComputeResources.Drain(
+				context.Background(),
+				1,
+				ComputeResourceDrainOptions{},
+			)
+			require.EqualError(
+				t,
+				err,
+				"migrate servers to compute resource 2: HTTP POST servers_migrations returns 400 status code",
+			)
+			assert.Empty(t, actual.Migrations)
+			assert.False(t, d.locked)
+		})
+
+		t.Run("failed migration", func(t *testing.T) {
+			d := &drainTestServer{
+				servers: []VirtualServer{
+					fakeSizedServer(1, 1, 1, 1),
+				},
+				taskStatus: TaskStatusFailed,
+			}
+
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				d.handle(t, w, r)
+			})
+			defer s.Close()
+
+			actual, err := createTestClient(t, s.URL).ComputeResources.Drain(
+				context.Background(),
+				1,
+				ComputeResourceDrainOptions{},
+			)
+			require.EqualError(t, err, "failed to migrate 1 servers")
+			require.Len(t, actual.Results, 1)
+			require.Error(t, actual.Results[0].Err)
+			assert.True(t, d.locked)
+		})
+
+		t.Run("failed maintenance", func(t *testing.T) {
+			d := &drainTestServer{
+				servers: []VirtualServer{
+					fakeSizedServer(1, 1, 1, 1),
+				},
+				taskStatus: TaskStatusDone,
+			}
+
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				d.handle(t, w, r)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).ComputeResources.Drain(
+				context.Background(),
+				1,
+				ComputeResourceDrainOptions{
+					Maintenance: func(context.Context) error { return errors.New("fake error") },
+				},
+			)
+			require.EqualError(t, err, "maintenance of compute resource 1: fake error")
+			assert.True(t, d.locked)
+		})
+	})
+}
@@ -31,6 +31,21 @@ const (
 	ComputeResourceInstallStepStatusError ComputeResourceInstallStepStatus = "error"
 )
 
+// ComputeResourceInstallStepError represents an error which is occurred due to
+// failed compute resource install step.
+type ComputeResourceInstallStepError struct {
+	Step ComputeResourceInstallStep
+}
+
+func (e ComputeResourceInstallStepError) Error() string {
+	return fmt.Sprintf(
+		"compute resource %d install step %q is failed: %s",
+		e.Step.ComputeResourceID,
+		e.Step.Title,
+		e.Step.StatusText,
+	)
+}
+
 // InstallSteps lists specified compute resource's install steps.
 func (s *ComputeResourcesService) InstallSteps(ctx context.Context, id int) ([]ComputeResourceInstallStep, error) {
 	var resp struct {
@@ -38,3 +53,38 @@ func (s *ComputeResourcesService) InstallSteps(ctx context.Context, id int) ([]C
 	}
 	return resp.Data, s.client.get(ctx, fmt.Sprintf("compute_resources/%d/install_steps", id), &resp)
 }
+
+// WaitInstalled waits until all install steps of specified compute resource
+// are done. The optional onStep callback is called every time an install step
+// status or progress is changed.
+// Returns ComputeResourceInstallStepError if any of install steps is failed.
+func (s *ComputeResourcesService) WaitInstalled(
+	ctx context.Context,
+	id int,
+	onStep func(ComputeResourceInstallStep),
+) error {
+	seen := map[int]ComputeResourceInstallStep{}
+	return s.client.poll(ctx, func(ctx context.Context) (bool, error) {
+		steps, err := s.InstallSteps(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		done := len(steps) > 0
+		for _, step := range steps {
+			if prev, ok := seen[step.ID]; (!ok || prev != step) && onStep != nil {
+				onStep(step)
+			}
+			seen[step.ID] = step
+
+			switch step.Status {
+			case ComputeResourceInstallStepStatusError:
+				return false, ComputeResourceInstallStepError{Step: step}
+			case ComputeResourceInstallStepStatusDone:
+			default:
+				done = false
+			}
+		}
+		return done, nil
+	})
+}
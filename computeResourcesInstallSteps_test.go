@@ -3,6 +3,7 @@ package solus
 import (
 	"context"
 	"net/http"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,3 +27,69 @@ func TestComputeResourcesService_InstallSteps(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expected, actual)
 }
+
+func TestComputeResourceInstallStepError_Error(t *testing.T) {
+	err := ComputeResourceInstallStepError{Step: fakeComputeResourceInstallStep}
+	assert.EqualError(t, err, `compute resource 2 install step "fake CR install step" is failed: fake status text`)
+}
+
+func TestComputeResourcesService_WaitInstalled(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		calls := int32(0)
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/compute_resources/10/install_steps", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			steps := []ComputeResourceInstallStep{}
+			switch atomic.AddInt32(&calls, 1) {
+			case 1:
+			case 2, 3:
+				steps = []ComputeResourceInstallStep{
+					{ID: 1, Status: ComputeResourceInstallStepStatusDone, Progress: 100},
+					{ID: 2, Status: ComputeResourceInstallStepStatusRunning, Progress: 50},
+				}
+			default:
+				steps = []ComputeResourceInstallStep{
+					{ID: 1, Status: ComputeResourceInstallStepStatusDone, Progress: 100},
+					{ID: 2, Status: ComputeResourceInstallStepStatusDone, Progress: 100},
+				}
+			}
+			writeResponse(t, w, http.StatusOK, steps)
+		})
+		defer s.Close()
+
+		var progress []float32
+		err := createTestClient(t, s.URL).ComputeResources.WaitInstalled(
+			context.Background(),
+			10,
+			func(step ComputeResourceInstallStep) { progress = append(progress, step.Progress) },
+		)
+		require.NoError(t, err)
+		require.Equal(t, int32(4), atomic.LoadInt32(&calls))
+		require.Equal(t, []float32{100, 50, 100}, progress)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed step", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(t, w, http.StatusOK, []ComputeResourceInstallStep{
+					fakeComputeResourceInstallStep,
+				})
+			})
+			defer s.Close()
+
+			err := createTestClient(t, s.URL).ComputeResources.WaitInstalled(context.Background(), 10, nil)
+			require.Equal(t, ComputeResourceInstallStepError{Step: fakeComputeResourceInstallStep}, err)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			err := createTestClient(t, s.URL).ComputeResources.WaitInstalled(context.Background(), 10, nil)
+			require.EqualError(t, err, "HTTP GET compute_resources/10/install_steps returns 400 status code")
+		})
+	})
+}
@@ -0,0 +1,81 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+)
+
+// ComputeResourceProvisionSpec represents all properties required for
+// provisioning a new compute resource.
+type ComputeResourceProvisionSpec struct {
+	ComputeResource ComputerResourceCreateRequest
+
+	// NetworkID an ID of the compute resource's network which should be set up.
+	// The network with the same IP as the compute resource's host is used if it's
+	// empty.
+	NetworkID   string
+	NetworkType ComputeResourceSettingsNetworkType
+
+	Storages []ComputeResourceStorageCreateRequest
+
+	// OnInstallStep an optional callback which is called every time an install
+	// step status or progress is changed.
+	OnInstallStep func(ComputeResourceInstallStep)
+}
+
+// Provision creates a new compute resource, waits until the agent is installed,
+// sets up the network and creates storages.
+// The created compute resource is returned even if some of the later steps are
+// failed, so the caller is able to clean up or continue manually.
+func (s *ComputeResourcesService) Provision(
+	ctx context.Context,
+	spec ComputeResourceProvisionSpec,
+) (ComputeResource, error) {
+	cr, err := s.Create(ctx, spec.ComputeResource)
+	if err != nil {
+		return ComputeResource{}, fmt.Errorf("create compute resource: %w", err)
+	}
+
+	if err := s.WaitInstalled(ctx, cr.ID, spec.OnInstallStep); err != nil {
+		return cr, err
+	}
+
+	networkID := spec.NetworkID
+	if networkID == "" {
+		networks, err := s.Networks(ctx, cr.ID)
+		if err != nil {
+			return cr, fmt.Errorf("list compute resource %d networks: %w", cr.ID, err)
+		}
+
+		for _, n := range networks {
+			if n.IP == spec.ComputeResource.Host {
+				networkID = n.ID
+				break
+			}
+		}
+
+		if networkID == "" {
+			return cr, fmt.Errorf("compute resource %d has no network with IP %q", cr.ID, spec.ComputeResource.Host)
+		}
+	}
+
+	err = s.SetUpNetwork(ctx, cr.ID, SetupNetworkRequest{
+		ID:   networkID,
+		Type: spec.NetworkType,
+	})
+	if err != nil {
+		return cr, fmt.Errorf("set up compute resource %d network: %w", cr.ID, err)
+	}
+
+	for _, storage := range spec.Storages {
+		if _, err := s.StorageCreate(ctx, cr.ID, storage); err != nil {
+			return cr, fmt.Errorf("create compute resource %d storage %q: %w", cr.ID, storage.Path, err)
+		}
+	}
+
+	updated, err := s.Get(ctx, cr.ID)
+	if err != nil {
+		return cr, err
+	}
+	return updated, nil
+}
@@ -0,0 +1,142 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeResourcesService_Provision(t *testing.T) {
+	spec := ComputeResourceProvisionSpec{
+		ComputeResource: ComputerResourceCreateRequest{
+			Name: "name",
+			Host: "192.0.2.1",
+		},
+		NetworkType: ComputeResourceSettingsNetworkTypeBridged,
+		Storages: []ComputeResourceStorageCreateRequest{
+			{Type: StorageTypeNameFB, Path: "/var/lib/storage"},
+		},
+	}
+
+	networks := []ComputeResourceNetwork{
+		{ID: "eth0", IP: "198.51.100.1"},
+		{ID: "eth1", IP: "192.0.2.1"},
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		var requests []string
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+
+			switch r.Method + " " + r.URL.Path {
+			case "POST /compute_resources":
+				assertRequestBody(t, r, spec.ComputeResource)
+				writeResponse(t, w, http.StatusCreated, ComputeResource{ID: 10})
+
+			case "GET /compute_resources/10/install_steps":
+				writeResponse(t, w, http.StatusOK, []ComputeResourceInstallStep{
+					{ID: 1, ComputeResourceID: 10, Status: ComputeResourceInstallStepStatusDone},
+				})
+
+			case "GET /compute_resources/10/networks":
+				writeResponse(t, w, http.StatusOK, networks)
+
+			case "POST /compute_resources/10/setup_network":
+				assertRequestBody(t, r, SetupNetworkRequest{
+					ID:   "eth1",
+					Type: ComputeResourceSettingsNetworkTypeBridged,
+				})
+				w.WriteHeader(http.StatusOK)
+
+			case "POST /compute_resources/10/storages":
+				assertRequestBody(t, r, spec.Storages[0])
+				writeResponse(t, w, http.StatusCreated, fakeStorage)
+
+			case "GET /compute_resources/10":
+				writeResponse(t, w, http.StatusOK, fakeComputeResource)
+
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		defer s.Close()
+
+		var steps []ComputeResourceInstallStep
+		spec := spec
+		spec.OnInstallStep = func(step ComputeResourceInstallStep) { steps = append(steps, step) }
+
+		actual, err := createTestClient(t, s.URL).ComputeResources.Provision(context.Background(), spec)
+		require.NoError(t, err)
+		require.Equal(t, fakeComputeResource, actual)
+		require.Len(t, steps, 1)
+		require.Equal(t, []string{
+			"POST /compute_resources",
+			"GET /compute_resources/10/install_steps",
+			"GET /compute_resources/10/networks",
+			"POST /compute_resources/10/setup_network",
+			"POST /compute_resources/10/storages",
+			"GET /compute_resources/10",
+		}, requests)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to create", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).ComputeResources.Provision(context.Background(), spec)
+			require.EqualError(t, err, "create compute resource: HTTP POST compute_resources returns 400 status code")
+		})
+
+		t.Run("failed install step", func(t *testing.T) {
+			failed := ComputeResourceInstallStep{
+				ID:                2,
+				ComputeResourceID: 10,
+				Title:             "Install agent",
+				Status:            ComputeResourceInstallStepStatusError,
+				StatusText:        "connection refused",
+			}
+
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					writeResponse(t, w, http.StatusCreated, ComputeResource{ID: 10})
+					return
+				}
+				writeResponse(t, w, http.StatusOK, []ComputeResourceInstallStep{failed})
+			})
+			defer s.Close()
+
+			actual, err := createTestClient(t, s.URL).ComputeResources.Provision(context.Background(), spec)
+			require.EqualError(t, err, `compute resource 10 install step "Install agent" is failed: connection refused`)
+			require.Equal(t, 10, actual.ID)
+
+			var stepErr ComputeResourceInstallStepError
+			require.ErrorAs(t, err, &stepErr)
+			assert.Equal(t, failed, stepErr.Step)
+		})
+
+		t.Run("network not found", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/compute_resources":
+					writeResponse(t, w, http.StatusCreated, ComputeResource{ID: 10})
+				case "/compute_resources/10/install_steps":
+					writeResponse(t, w, http.StatusOK, []ComputeResourceInstallStep{
+						{ID: 1, Status: ComputeResourceInstallStepStatusDone},
+					})
+				case "/compute_resources/10/networks":
+					writeResponse(t, w, http.StatusOK, networks[:1])
+				}
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).ComputeResources.Provision(context.Background(), spec)
+			require.EqualError(t, err, `compute resource 10 has no network with IP "192.0.2.1"`)
+		})
+	})
+}
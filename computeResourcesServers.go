@@ -32,3 +32,13 @@ func (s *ComputeResourcesService) ServersCreate(
 	var resp virtualServerResponse
 	return resp.Data, s.client.create(ctx, fmt.Sprintf("compute_resources/%d/servers", id), data, &resp)
 }
+
+// Servers lists servers on the specified compute resource.
+func (s *ComputeResourcesService) Servers(ctx context.Context, id int) (VirtualServersResponse, error) {
+	resp := VirtualServersResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, fmt.Sprintf("compute_resources/%d/servers", id), &resp)
+}
@@ -40,3 +40,24 @@ func TestComputeResourcesService_ServersCreate(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, fakeVirtualServer, actual)
 }
+
+func TestComputeResourcesService_Servers(t *testing.T) {
+	expected := VirtualServersResponse{
+		Data: []VirtualServer{
+			fakeVirtualServer,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/compute_resources/10/servers", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ComputeResources.Servers(context.Background(), 10)
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
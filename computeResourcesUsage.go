@@ -0,0 +1,33 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+)
+
+// ComputeResourceUsage represents actual resources usage of a compute resource.
+type ComputeResourceUsage struct {
+	// CPU a CPU usage in percents.
+	CPU float64 `json:"cpu"`
+
+	// LoadAverage a system load average for 1, 5 and 15 minutes.
+	LoadAverage []float64 `json:"load_average"`
+
+	RAM  ComputeResourceUsageValue `json:"ram"`
+	Disk ComputeResourceUsageValue `json:"disk"`
+}
+
+// ComputeResourceUsageValue represents total and used amount of a compute
+// resource's resource in bytes.
+type ComputeResourceUsageValue struct {
+	Total int64 `json:"total"`
+	Used  int64 `json:"used"`
+}
+
+// Usage gets specified compute resource's actual resources usage.
+func (s *ComputeResourcesService) Usage(ctx context.Context, id int) (ComputeResourceUsage, error) {
+	var resp struct {
+		Data ComputeResourceUsage `json:"data"`
+	}
+	return resp.Data, s.client.get(ctx, fmt.Sprintf("compute_resources/%d/usage", id), &resp)
+}
@@ -0,0 +1,31 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeResourcesService_Usage(t *testing.T) {
+	expected := ComputeResourceUsage{
+		CPU:         42,
+		LoadAverage: []float64{1, 2, 3},
+		RAM:         ComputeResourceUsageValue{Total: 1024, Used: 512},
+		Disk:        ComputeResourceUsageValue{Total: 2048, Used: 1},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/compute_resources/10/usage", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeResponse(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ComputeResources.Usage(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
@@ -4,11 +4,13 @@ go 1.21
 
 require (
 	github.com/stretchr/testify v1.7.0
+	golang.org/x/crypto v0.21.0
 	gopkg.in/guregu/null.v4 v4.0.0
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.18.0 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
 )
@@ -0,0 +1,37 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+)
+
+// Health represents health status of the panel.
+type Health struct {
+	Status  string `json:"status"`
+	Version string `json:"version"`
+}
+
+// Health checks the panel is reachable and returns its version. Credentials
+// aren't required, so it doesn't trigger lazy authentication.
+func (c *Client) Health(ctx context.Context) (Health, error) {
+	const path = "health"
+	body, code, err := c.request(ctx, http.MethodGet, path, withoutAuthentication())
+	if err != nil {
+		return Health{}, err
+	}
+
+	if code != http.StatusOK {
+		return Health{}, newHTTPError(http.MethodGet, path, code, body)
+	}
+
+	var resp struct {
+		Data Health `json:"data"`
+	}
+	return resp.Data, unmarshal(body, &resp)
+}
+
+// Ping checks the panel is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.Health(ctx)
+	return err
+}
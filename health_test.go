@@ -0,0 +1,61 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Health(t *testing.T) {
+	expected := Health{
+		Status:  "ok",
+		Version: "1.2.3",
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/health", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			writeResponse(t, w, http.StatusOK, expected)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Health(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to make request", func(t *testing.T) {
+			asserter, addr := startBrokenTestServer(t)
+			_, err := createTestClient(t, addr).Health(context.Background())
+			asserter(t, http.MethodGet, "/health", err)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Health(context.Background())
+			require.EqualError(t, err, "HTTP GET health returns 400 status code")
+		})
+	})
+}
+
+func TestClient_Ping(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/health", r.URL.Path)
+
+		writeResponse(t, w, http.StatusOK, Health{Status: "ok"})
+	})
+	defer s.Close()
+
+	err := createTestClient(t, s.URL).Ping(context.Background())
+	require.NoError(t, err)
+}
@@ -83,9 +83,20 @@ var fakeIPBlock = IPBlock{
 }
 
 var fakeIPBlockIPAddress = IPBlockIPAddress{
-	ID:      1,
-	IP:      "192.0.2.2",
-	IPBlock: fakeIPBlock,
+	ID:        1,
+	IP:        "192.0.2.2",
+	IPBlock:   fakeIPBlock,
+	IsPrimary: true,
+	ReverseDNS: []ReverseDNS{
+		fakeReverseDNS,
+	},
+}
+
+var fakeReverseDNS = ReverseDNS{
+	ID:     1,
+	IPID:   1,
+	IP:     "192.0.2.2",
+	Domain: "example.com",
 }
 
 var fakeLicense = License{
@@ -204,6 +215,12 @@ var fakePlan = Plan{
 	},
 }
 
+var fakeAPIToken = APIToken{
+	ID:        1,
+	Name:      "fake api token",
+	CreatedAt: time.Now().String(),
+}
+
 var fakeProject = Project{
 	ID:          1,
 	Name:        "fake project",
@@ -215,6 +232,14 @@ var fakeProject = Project{
 	Servers:     1,
 }
 
+var fakeProjectMember = ProjectMember{
+	ID:      1,
+	Email:   fakeUser.Email,
+	Status:  ProjectMemberStatusAccepted,
+	IsOwner: false,
+	User:    fakeUser,
+}
+
 var fakeUser = User{
 	ID:        1,
 	Email:     "fake@example.com",
@@ -261,10 +286,16 @@ var fakeTask = Task{
 	Duration:          23,
 }
 
+const (
+	fakeSSHKeyBody = "ssh-ed25519 " +
+		"AAAAC3NzaC1lZDI1NTE5AAAAIFjlz4pk8WNZQtkZ6iuH3cK9uGO3yF43ti+u1t6kUhxB fake@example.com"
+	fakeSSHKeyFingerprint = "SHA256:PfgXkkNb/gL3ZCRILXx3aKvsc56WIMhzQuwQkJZUK9k"
+)
+
 var fakeSSHKey = SSHKey{
 	ID:   1,
 	Name: "fake ssh key",
-	Body: "fake ssh key body",
+	Body: fakeSSHKeyBody,
 }
 
 var fakeStorage = Storage{
@@ -272,7 +303,7 @@ var fakeStorage = Storage{
 	Name: "fake storage",
 	Type: StorageType{
 		ID:      1,
-		Name:    "fake storage",
+		Name:    StorageTypeNameNFS,
 		Formats: []ImageFormat{ImageFormatRaw},
 	},
 	Path:                    "fake path",
@@ -282,8 +313,9 @@ var fakeStorage = Storage{
 	ServersCount:            2,
 	ComputeResourcesCount:   3,
 	FreeSpace:               4,
-	Credentials: map[string]interface{}{
-		"foo": "bar",
+	Credentials: StorageNFSCredentials{
+		Server: "192.0.2.2",
+		Folder: "/export",
 	},
 }
 
@@ -301,8 +333,12 @@ var fakeBackupNode = BackupNode{
 	ID:   1,
 	Name: "fake backup node",
 	Type: BackupNodeTypeSSHRsync,
-	Credentials: map[string]interface{}{
-		"foo": "bar",
+	Credentials: BackupNodeCredentialsSSHRsync{
+		Host:        "192.0.2.3",
+		Port:        22,
+		Login:       "root",
+		Key:         "private key",
+		StoragePath: "/backups",
 	},
 	ComputeResourcesCount: 1,
 	BackupsCount:          2,
@@ -385,7 +421,7 @@ func createTestClient(t *testing.T, addr string) *Client {
 	u, err := url.Parse(addr)
 	require.NoError(t, err)
 
-	c, err := NewClient(u, authenticator{}, SetRetryPolicy(0, 0))
+	c, err := NewClient(u, authenticator{}, SetRetryPolicy(0, 0), SetPollInterval(time.Millisecond))
 	require.NoError(t, err)
 	return c
 }
@@ -409,6 +445,21 @@ func assertRequestBody(t *testing.T, r *http.Request, expected interface{}) {
 	assert.Equal(t, expected, reflect.ValueOf(d).Elem().Interface())
 }
 
+// assertRequestJSON compares the request body with JSON representation of
+// expected value. It's useful when the value can't be decoded as is, e.g. it
+// contains interfaces.
+func assertRequestJSON(t *testing.T, r *http.Request, expected interface{}) {
+	t.Helper()
+
+	b, err := ioutil.ReadAll(r.Body)
+	require.NoError(t, err)
+
+	e, err := json.Marshal(expected)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(e), string(b))
+}
+
 func writeJSON(t *testing.T, w http.ResponseWriter, statusCode int, r interface{}) {
 	t.Helper()
 
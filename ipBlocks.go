@@ -9,6 +9,9 @@ import (
 type IPBlocksService service
 
 // IPBlock represents an IP block.
+// IPv4 IP block is defined by From and To addresses. IPv6 IP block is defined
+// by Range in CIDR notation, and each server gets a whole IPv6 subnet from it
+// which prefix length is Subnet.
 type IPBlock struct {
 	ID               int                `json:"id"`
 	Name             string             `json:"name"`
@@ -22,8 +25,8 @@ type IPBlock struct {
 	To               string             `json:"to"`
 	Subnet           int                `json:"subnet"`
 	Range            string             `json:"range"`
-	ComputeResources []ComputeResource  `json:"compute_resources[]"`
-	IPs              []IPBlockIPAddress `json:"ips[]"`
+	ComputeResources []ComputeResource  `json:"compute_resources"`
+	IPs              []IPBlockIPAddress `json:"ips"`
 	ReverseDNS       IPBlockReverseDNS  `json:"reverse_dns"`
 }
 
@@ -67,8 +70,11 @@ type IPBlockRequest struct {
 	To      string `json:"to,omitempty"`
 
 	// IPv6 related fields
-	Range  string `json:"range,omitempty"`
-	Subnet int    `json:"subnet,omitempty"`
+	// Range an IPv6 network in CIDR notation, e.g. 2001:db8::/48.
+	Range string `json:"range,omitempty"`
+	// Subnet a prefix length of IPv6 subnet which is assigned to each server,
+	// e.g. 64.
+	Subnet int `json:"subnet,omitempty"`
 }
 
 // IPBlockReverseDNS represents an IP block's reverse DNS settings.
@@ -79,9 +85,54 @@ type IPBlockReverseDNS struct {
 
 // IPBlockIPAddress represents an IP block's IP address.
 type IPBlockIPAddress struct {
-	ID      int     `json:"id"`
-	IP      string  `json:"ip"`
-	IPBlock IPBlock `json:"ip_block"`
+	ID                  int          `json:"id"`
+	IP                  string       `json:"ip"`
+	IPBlock             IPBlock      `json:"ip_block"`
+	IsPrimary           bool         `json:"is_primary"`
+	IsReserved          bool         `json:"is_reserved"`
+	ComputeResourceVMID int          `json:"compute_resource_vm_id"`
+	ReverseDNS          []ReverseDNS `json:"reverse_dns"`
+}
+
+// IPAddressStatus represents available assignment statuses of an IP address.
+type IPAddressStatus string
+
+const (
+	// IPAddressStatusFree indicates IP address isn't used by anyone.
+	IPAddressStatusFree IPAddressStatus = "free"
+
+	// IPAddressStatusReserved indicates IP address is reserved and can't be
+	// automatically assigned to a server.
+	IPAddressStatusReserved IPAddressStatus = "reserved"
+
+	// IPAddressStatusAssigned indicates IP address is assigned to a server.
+	IPAddressStatusAssigned IPAddressStatus = "assigned"
+)
+
+// Status returns assignment status of the IP address.
+func (a IPBlockIPAddress) Status() IPAddressStatus {
+	switch {
+	case a.ComputeResourceVMID != 0:
+		return IPAddressStatusAssigned
+	case a.IsReserved:
+		return IPAddressStatusReserved
+	default:
+		return IPAddressStatusFree
+	}
+}
+
+// IPBlockIPAddressesResponse represents paginated list of IP block's IP
+// addresses.
+// This cursor can be used for iterating over all available IP addresses.
+type IPBlockIPAddressesResponse struct {
+	paginatedResponse
+
+	Data []IPBlockIPAddress `json:"data"`
+}
+
+type ipBlockIPAddressCreateRequest struct {
+	IP         string `json:"ip"`
+	IsReserved bool   `json:"is_reserved"`
 }
 
 // IPBlocksResponse represents paginated list of IP blocks.
@@ -114,12 +165,20 @@ func (s *IPBlocksService) Get(ctx context.Context, id int) (IPBlock, error) {
 
 // Create creates new IP block.
 func (s *IPBlocksService) Create(ctx context.Context, data IPBlockRequest) (IPBlock, error) {
+	if err := data.Validate(); err != nil {
+		return IPBlock{}, err
+	}
+
 	var resp ipBlockResponse
 	return resp.Data, s.client.create(ctx, "ip_blocks", data, &resp)
 }
 
 // Update updates specified IP block.
 func (s *IPBlocksService) Update(ctx context.Context, id int, data IPBlockRequest) (IPBlock, error) {
+	if err := data.Validate(); err != nil {
+		return IPBlock{}, err
+	}
+
 	var resp ipBlockResponse
 	return resp.Data, s.client.update(ctx, fmt.Sprintf("ip_blocks/%d", id), data, &resp)
 }
@@ -137,6 +196,30 @@ func (s *IPBlocksService) IPAddressCreate(ctx context.Context, ipBlockID int) (I
 	return resp.Data, s.client.create(ctx, fmt.Sprintf("ip_blocks/%d/ips", ipBlockID), nil, &resp)
 }
 
+// IPAddresses lists IP addresses of the specified IP block.
+func (s *IPBlocksService) IPAddresses(ctx context.Context, ipBlockID int) (IPBlockIPAddressesResponse, error) {
+	resp := IPBlockIPAddressesResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, fmt.Sprintf("ip_blocks/%d/ips", ipBlockID), &resp)
+}
+
+// IPAddressReserve reserves the specified IP address in the IP block, so it
+// won't be automatically assigned to any server.
+func (s *IPBlocksService) IPAddressReserve(ctx context.Context, ipBlockID int, ip string) (IPBlockIPAddress, error) {
+	var resp struct {
+		Data IPBlockIPAddress `json:"data"`
+	}
+	return resp.Data, s.client.create(
+		ctx,
+		fmt.Sprintf("ip_blocks/%d/ips", ipBlockID),
+		ipBlockIPAddressCreateRequest{IP: ip, IsReserved: true},
+		&resp,
+	)
+}
+
 // IPAddressDelete deletes a provided IP address in the specified IP block.
 func (s *IPBlocksService) IPAddressDelete(ctx context.Context, id int) error {
 	return s.client.syncDelete(ctx, fmt.Sprintf("ips/%d", id))
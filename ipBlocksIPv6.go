@@ -0,0 +1,158 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+)
+
+// IPv6Subnet represents an IPv6 subnet allocated from an IPv6 IP block.
+// Each virtual server gets a whole subnet of IPBlock.Subnet size instead of a
+// single IPv6 address.
+type IPv6Subnet struct {
+	// ID an ID of the IP address which represents the subnet.
+	ID                  int
+	Prefix              netip.Prefix
+	IsReserved          bool
+	ComputeResourceVMID int
+}
+
+// Validate checks IPv6 range and size of per-server subnets of IPv6 range IP
+// blocks. Other IP blocks aren't validated.
+func (r IPBlockRequest) Validate() error {
+	if r.Type != IPv6 || r.ListType != IpBlockListTypeRange {
+		return nil
+	}
+	_, err := parseIPv6Range(r.Range, r.Subnet)
+	return err
+}
+
+// parseIPv6Range parses IPv6 range and checks the subnet size fits into it.
+// Zero subnet size means the range isn't split into subnets.
+func parseIPv6Range(r string, subnet int) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(r)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid IPv6 range %q: %w", r, err)
+	}
+
+	if !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		return netip.Prefix{}, fmt.Errorf("range %q isn't IPv6 range", r)
+	}
+
+	if prefix.Masked() != prefix {
+		return netip.Prefix{}, fmt.Errorf("range %q has host bits set, use %q instead", r, prefix.Masked())
+	}
+
+	if subnet != 0 && (subnet < prefix.Bits() || subnet > 128) {
+		return netip.Prefix{}, fmt.Errorf(
+			"subnet size /%d should be between range size /%d and /128",
+			subnet,
+			prefix.Bits(),
+		)
+	}
+	return prefix, nil
+}
+
+// IPv6Subnet returns a subnet which the IP address belongs to according to the
+// IP block's subnet size.
+func (b IPBlock) IPv6Subnet(ip IPBlockIPAddress) (IPv6Subnet, error) {
+	if b.Type != IPv6 {
+		return IPv6Subnet{}, fmt.Errorf("IP block %d isn't IPv6 IP block", b.ID)
+	}
+
+	addr, err := netip.ParseAddr(ip.IP)
+	if err != nil {
+		return IPv6Subnet{}, fmt.Errorf("invalid IP address %q: %w", ip.IP, err)
+	}
+
+	prefix, err := addr.Prefix(b.Subnet)
+	if err != nil {
+		return IPv6Subnet{}, fmt.Errorf("invalid subnet size /%d: %w", b.Subnet, err)
+	}
+
+	return IPv6Subnet{
+		ID:                  ip.ID,
+		Prefix:              prefix,
+		IsReserved:          ip.IsReserved,
+		ComputeResourceVMID: ip.ComputeResourceVMID,
+	}, nil
+}
+
+// IPv6Subnets lists subnets allocated from the specified IPv6 IP block.
+func (s *IPBlocksService) IPv6Subnets(ctx context.Context, ipBlockID int) ([]IPv6Subnet, error) {
+	block, err := s.Get(ctx, ipBlockID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.IPAddresses(ctx, ipBlockID)
+	if err != nil {
+		return nil, err
+	}
+
+	var subnets []IPv6Subnet
+	for {
+		for _, ip := range resp.Data {
+			subnet, err := block.IPv6Subnet(ip)
+			if err != nil {
+				return nil, err
+			}
+			subnets = append(subnets, subnet)
+		}
+		if !resp.Next(ctx) {
+			break
+		}
+	}
+	if resp.Err() != nil {
+		return nil, resp.Err()
+	}
+	return subnets, nil
+}
+
+// IPv6SubnetAssign assigns a subnet from the IPv6 IP block to the specified
+// virtual server. The next free subnet is used if the subnet is zero value.
+// Otherwise, it should belong to the IP block's range and have the IP block's
+// subnet size.
+func (s *VirtualServersService) IPv6SubnetAssign(
+	ctx context.Context,
+	id int,
+	block IPBlock,
+	subnet netip.Prefix,
+) (Task, error) {
+	if block.Type != IPv6 {
+		return Task{}, fmt.Errorf("IP block %d isn't IPv6 IP block", block.ID)
+	}
+
+	data := VirtualServerIPAddressRequest{
+		Type:      IPv6,
+		IPBlockID: block.ID,
+	}
+
+	if subnet.IsValid() {
+		r, err := parseIPv6Range(block.Range, block.Subnet)
+		if err != nil {
+			return Task{}, err
+		}
+
+		if subnet.Bits() != block.Subnet {
+			return Task{}, fmt.Errorf("subnet %s should have /%d size", subnet, block.Subnet)
+		}
+
+		if subnet.Masked() != subnet {
+			return Task{}, fmt.Errorf("subnet %s has host bits set, use %s instead", subnet, subnet.Masked())
+		}
+
+		if !r.Contains(subnet.Addr()) {
+			return Task{}, fmt.Errorf("subnet %s doesn't belong to range %s", subnet, r)
+		}
+
+		data.IP = subnet.Addr().String()
+	}
+
+	return s.IPAddressAttach(ctx, id, data)
+}
+
+// IPv6SubnetRemove removes the IPv6 subnet from the specified virtual server.
+func (s *VirtualServersService) IPv6SubnetRemove(ctx context.Context, id int, subnet IPv6Subnet) (Task, error) {
+	return s.IPAddressDetach(ctx, id, subnet.ID)
+}
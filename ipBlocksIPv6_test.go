@@ -0,0 +1,262 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fakeIPv6Block = IPBlock{
+	ID:       10,
+	Type:     IPv6,
+	ListType: IpBlockListTypeRange,
+	Range:    "2001:db8::/48",
+	Subnet:   64,
+}
+
+func TestIPBlockRequest_Validate(t *testing.T) {
+	cc := map[string]struct {
+		request  IPBlockRequest
+		expected string
+	}{
+		"IPv4": {
+			request: IPBlockRequest{Type: IPv4},
+		},
+		"valid IPv6": {
+			request: IPBlockRequest{Type: IPv6, ListType: IpBlockListTypeRange, Range: "2001:db8::/48", Subnet: 64},
+		},
+		"IPv6 without subnet": {
+			request: IPBlockRequest{Type: IPv6, ListType: IpBlockListTypeRange, Range: "2001:db8::/48"},
+		},
+		"IPv6 set": {
+			request: IPBlockRequest{Type: IPv6, ListType: IpBlockListTypeSet},
+		},
+		"invalid range": {
+			request:  IPBlockRequest{Type: IPv6, ListType: IpBlockListTypeRange, Range: "2001:db8::", Subnet: 64},
+			expected: `invalid IPv6 range "2001:db8::": netip.ParsePrefix("2001:db8::"): no '/'`,
+		},
+		"IPv4 range": {
+			request:  IPBlockRequest{Type: IPv6, ListType: IpBlockListTypeRange, Range: "192.0.2.0/24", Subnet: 28},
+			expected: `range "192.0.2.0/24" isn't IPv6 range`,
+		},
+		"host bits": {
+			request:  IPBlockRequest{Type: IPv6, ListType: IpBlockListTypeRange, Range: "2001:db8::1/48", Subnet: 64},
+			expected: `range "2001:db8::1/48" has host bits set, use "2001:db8::/48" instead`,
+		},
+		"too big subnet": {
+			request:  IPBlockRequest{Type: IPv6, ListType: IpBlockListTypeRange, Range: "2001:db8::/48", Subnet: 32},
+			expected: "subnet size /32 should be between range size /48 and /128",
+		},
+		"too small subnet": {
+			request:  IPBlockRequest{Type: IPv6, ListType: IpBlockListTypeRange, Range: "2001:db8::/48", Subnet: 129},
+			expected: "subnet size /129 should be between range size /48 and /128",
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			err := c.request.Validate()
+			if c.expected == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, c.expected)
+		})
+	}
+}
+
+func TestIPBlocksService_Create_Validate(t *testing.T) {
+	_, err := createTestClient(t, "http://example.com").IPBlocks.Create(
+		context.Background(),
+		IPBlockRequest{Type: IPv6, ListType: IpBlockListTypeRange, Range: "2001:db8::/48", Subnet: 32},
+	)
+	require.EqualError(t, err, "subnet size /32 should be between range size /48 and /128")
+}
+
+func TestIPBlock_IPv6Subnet(t *testing.T) {
+	actual, err := fakeIPv6Block.IPv6Subnet(IPBlockIPAddress{
+		ID:                  1,
+		IP:                  "2001:db8:0:1::",
+		ComputeResourceVMID: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, IPv6Subnet{
+		ID:                  1,
+		Prefix:              netip.MustParsePrefix("2001:db8:0:1::/64"),
+		ComputeResourceVMID: 2,
+	}, actual)
+
+	_, err = fakeIPBlock.IPv6Subnet(IPBlockIPAddress{IP: "192.0.2.1"})
+	require.EqualError(t, err, "IP block 1 isn't IPv6 IP block")
+}
+
+func TestIPBlocksService_IPv6Subnets(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			switch r.URL.Path {
+			case "/ip_blocks/10":
+				writeResponse(t, w, http.StatusOK, fakeIPv6Block)
+
+			case "/ip_blocks/10/ips":
+				writeJSON(t, w, http.StatusOK, IPBlockIPAddressesResponse{
+					Data: []IPBlockIPAddress{
+						{ID: 1, IP: "2001:db8::", ComputeResourceVMID: 1},
+						{ID: 2, IP: "2001:db8:0:1::", IsReserved: true},
+					},
+				})
+
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).IPBlocks.IPv6Subnets(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, []IPv6Subnet{
+			{ID: 1, Prefix: netip.MustParsePrefix("2001:db8::/64"), ComputeResourceVMID: 1},
+			{ID: 2, Prefix: netip.MustParsePrefix("2001:db8:0:1::/64"), IsReserved: true},
+		}, actual)
+	})
+
+	t.Run("failed to fetch next page", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/ip_blocks/10":
+				writeResponse(t, w, http.StatusOK, fakeIPv6Block)
+
+			case r.URL.Path == "/ip_blocks/10/ips" && r.URL.Query().Get("page") == "":
+				writeJSON(t, w, http.StatusOK, IPBlockIPAddressesResponse{
+					paginatedResponse: paginatedResponse{
+						Links: ResponseLinks{Next: r.URL.Path + "?page=2"},
+						Meta:  ResponseMeta{CurrentPage: 1, LastPage: 2},
+					},
+					Data: []IPBlockIPAddress{{ID: 1, IP: "2001:db8::", ComputeResourceVMID: 1}},
+				})
+
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).IPBlocks.IPv6Subnets(context.Background(), 10)
+		require.Error(t, err)
+		require.Nil(t, actual)
+	})
+}
+
+func TestVirtualServersService_IPv6SubnetAssign(t *testing.T) {
+	t.Run("next free subnet", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/servers/5/ips", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assertRequestBody(t, r, VirtualServerIPAddressRequest{Type: IPv6, IPBlockID: 10})
+
+			writeResponse(t, w, http.StatusOK, fakeTask)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).VirtualServers.IPv6SubnetAssign(
+			context.Background(),
+			5,
+			fakeIPv6Block,
+			netip.Prefix{},
+		)
+		require.NoError(t, err)
+		require.Equal(t, fakeTask, actual)
+	})
+
+	t.Run("specific subnet", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/servers/5/ips", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assertRequestBody(t, r, VirtualServerIPAddressRequest{
+				Type:      IPv6,
+				IPBlockID: 10,
+				IP:        "2001:db8:0:2::",
+			})
+
+			writeResponse(t, w, http.StatusOK, fakeTask)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).VirtualServers.IPv6SubnetAssign(
+			context.Background(),
+			5,
+			fakeIPv6Block,
+			netip.MustParsePrefix("2001:db8:0:2::/64"),
+		)
+		require.NoError(t, err)
+		require.Equal(t, fakeTask, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			block    IPBlock
+			subnet   string
+			expected string
+		}{
+			"IPv4 block": {
+				block:    fakeIPBlock,
+				expected: "IP block 1 isn't IPv6 IP block",
+			},
+			"invalid size": {
+				block:    fakeIPv6Block,
+				subnet:   "2001:db8::/56",
+				expected: "subnet 2001:db8::/56 should have /64 size",
+			},
+			"host bits": {
+				block:    fakeIPv6Block,
+				subnet:   "2001:db8::1/64",
+				expected: "subnet 2001:db8::1/64 has host bits set, use 2001:db8::/64 instead",
+			},
+			"out of range": {
+				block:    fakeIPv6Block,
+				subnet:   "2001:db9::/64",
+				expected: "subnet 2001:db9::/64 doesn't belong to range 2001:db8::/48",
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				var subnet netip.Prefix
+				if c.subnet != "" {
+					subnet = netip.MustParsePrefix(c.subnet)
+				}
+
+				_, err := createTestClient(t, "http://example.com").VirtualServers.IPv6SubnetAssign(
+					context.Background(),
+					5,
+					c.block,
+					subnet,
+				)
+				require.EqualError(t, err, c.expected)
+			})
+		}
+	})
+}
+
+func TestVirtualServersService_IPv6SubnetRemove(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers/5/ips/7", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeTask)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).VirtualServers.IPv6SubnetRemove(
+		context.Background(),
+		5,
+		IPv6Subnet{ID: 7},
+	)
+	require.NoError(t, err)
+	require.Equal(t, fakeTask, actual)
+}
@@ -0,0 +1,334 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"sort"
+)
+
+// IPBlockPool represents address space of an IP block. It's calculated on the
+// client side and allows to find out the IP block's utilisation and next free
+// IP addresses without allocating them.
+// IPv6 range IP block with specified subnet size is split into per-server
+// subnets, so the pool operates with subnets instead of single addresses.
+type IPBlockPool struct {
+	block    IPBlock
+	first    netip.Addr
+	last     netip.Addr
+	gateway  netip.Addr
+	set      []netip.Addr
+	statuses map[netip.Addr]IPAddressStatus
+
+	// bits a prefix length of per-server subnets. It's 0 if the pool consists
+	// of single addresses.
+	bits int
+}
+
+// IPBlockUtilization represents utilisation of an IP block.
+type IPBlockUtilization struct {
+	// Total number of IP addresses in the block excluding the gateway, since
+	// it can't be assigned to servers. It's saturated to math.MaxUint64 for
+	// huge IPv6 ranges.
+	Total    uint64
+	Assigned int
+	Reserved int
+}
+
+// Free returns number of IP addresses which aren't assigned or reserved.
+func (u IPBlockUtilization) Free() uint64 {
+	used := uint64(u.Assigned + u.Reserved)
+	if used > u.Total {
+		return 0
+	}
+	return u.Total - used
+}
+
+// Percent returns percent of used IP addresses.
+func (u IPBlockUtilization) Percent() float64 {
+	if u.Total == 0 {
+		return 0
+	}
+	return float64(u.Assigned+u.Reserved) / float64(u.Total) * 100
+}
+
+// NewIPBlockPool creates a pool for the IP block.
+// The IP block's range is defined by From and To for IPv4 and by Range for IPv6
+// if it's an IpBlockListTypeRange block. The IpBlockListTypeSet block consists
+// only of the specified IP addresses.
+// The IP addresses are used to determine which addresses are assigned or
+// reserved, IPBlock.IPs are used if they aren't specified.
+func NewIPBlockPool(block IPBlock, ips []IPBlockIPAddress) (IPBlockPool, error) {
+	if ips == nil {
+		ips = block.IPs
+	}
+
+	p := IPBlockPool{
+		block:    block,
+		statuses: make(map[netip.Addr]IPAddressStatus, len(ips)),
+	}
+
+	if block.Type == IPv6 && block.ListType == IpBlockListTypeRange && block.Subnet > 0 {
+		if _, err := parseIPv6Range(block.Range, block.Subnet); err != nil {
+			return IPBlockPool{}, err
+		}
+		p.bits = block.Subnet
+	}
+
+	for _, ip := range ips {
+		addr, err := netip.ParseAddr(ip.IP)
+		if err != nil {
+			return IPBlockPool{}, fmt.Errorf("invalid IP address %q: %w", ip.IP, err)
+		}
+		p.statuses[p.key(addr)] = ip.Status()
+	}
+
+	if block.Gateway != "" {
+		gw, err := netip.ParseAddr(block.Gateway)
+		if err != nil {
+			return IPBlockPool{}, fmt.Errorf("invalid gateway %q: %w", block.Gateway, err)
+		}
+		p.gateway = gw
+	}
+
+	switch block.ListType {
+	case IpBlockListTypeSet:
+		for addr := range p.statuses {
+			p.set = append(p.set, addr)
+		}
+		sort.Slice(p.set, func(i, j int) bool {
+			return p.set[i].Less(p.set[j])
+		})
+
+	case IpBlockListTypeRange:
+		first, last, err := ipBlockRange(block)
+		if err != nil {
+			return IPBlockPool{}, err
+		}
+		p.first = first
+		p.last = last
+
+	default:
+		return IPBlockPool{}, fmt.Errorf("unsupported IP block list type %q", block.ListType)
+	}
+	return p, nil
+}
+
+// ipBlockRange returns the first and the last IP address of IP block's range.
+func ipBlockRange(block IPBlock) (netip.Addr, netip.Addr, error) {
+	if block.Type == IPv6 {
+		prefix, err := netip.ParsePrefix(block.Range)
+		if err != nil {
+			return netip.Addr{}, netip.Addr{}, fmt.Errorf("invalid range %q: %w", block.Range, err)
+		}
+		return prefix.Masked().Addr(), lastAddr(prefix), nil
+	}
+
+	first, err := netip.ParseAddr(block.From)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("invalid range start %q: %w", block.From, err)
+	}
+
+	last, err := netip.ParseAddr(block.To)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("invalid range end %q: %w", block.To, err)
+	}
+
+	if first.BitLen() != last.BitLen() || last.Less(first) {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("invalid range %s-%s", first, last)
+	}
+	return first, last, nil
+}
+
+// lastAddr returns the last IP address of the prefix.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Masked().Addr()
+	b := addr.AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 1 << (7 - uint(i%8))
+	}
+	last, _ := netip.AddrFromSlice(b)
+	return last
+}
+
+// key returns a key of the IP address in the pool's statuses. It's the
+// subnet's address if the pool is split into subnets.
+func (p IPBlockPool) key(addr netip.Addr) netip.Addr {
+	if p.bits == 0 {
+		return addr
+	}
+	prefix, err := addr.Prefix(p.bits)
+	if err != nil {
+		return addr
+	}
+	return prefix.Addr()
+}
+
+// next returns the next address or the next subnet's address.
+func (p IPBlockPool) next(addr netip.Addr) netip.Addr {
+	if p.bits == 0 {
+		return addr.Next()
+	}
+
+	v := new(big.Int).SetBytes(addr.AsSlice())
+	v.Add(v, new(big.Int).Lsh(big.NewInt(1), uint(addr.BitLen()-p.bits)))
+
+	b := v.Bytes()
+	if len(b) > addr.BitLen()/8 {
+		// The last subnet is reached.
+		return netip.Addr{}
+	}
+
+	res := make([]byte, addr.BitLen()/8)
+	copy(res[len(res)-len(b):], b)
+	next, _ := netip.AddrFromSlice(res)
+	return next
+}
+
+// Contains returns true if the IP address belongs to the pool.
+func (p IPBlockPool) Contains(addr netip.Addr) bool {
+	if p.block.ListType == IpBlockListTypeSet {
+		_, ok := p.statuses[addr]
+		return ok
+	}
+	return addr.BitLen() == p.first.BitLen() && !addr.Less(p.first) && !p.last.Less(addr)
+}
+
+// Status returns assignment status of the IP address. The second returned
+// value is false if the address doesn't belong to the pool.
+func (p IPBlockPool) Status(addr netip.Addr) (IPAddressStatus, bool) {
+	if !p.Contains(addr) {
+		return "", false
+	}
+
+	if s, ok := p.statuses[p.key(addr)]; ok {
+		return s, true
+	}
+	return IPAddressStatusFree, true
+}
+
+// hasGateway returns true if the IP block's gateway belongs to the pool.
+func (p IPBlockPool) hasGateway() bool {
+	return p.gateway.IsValid() && p.Contains(p.gateway)
+}
+
+// Utilization calculates utilisation of the pool. The gateway isn't counted
+// if it belongs to the pool.
+func (p IPBlockPool) Utilization() IPBlockUtilization {
+	var u IPBlockUtilization
+	hasGateway := p.hasGateway()
+	for addr, s := range p.statuses {
+		if !p.Contains(addr) || (hasGateway && addr == p.key(p.gateway)) {
+			continue
+		}
+
+		switch s {
+		case IPAddressStatusAssigned:
+			u.Assigned++
+		case IPAddressStatusReserved:
+			u.Reserved++
+		}
+	}
+
+	if p.block.ListType == IpBlockListTypeSet {
+		u.Total = uint64(len(p.set))
+		if hasGateway {
+			u.Total--
+		}
+		return u
+	}
+
+	size := new(big.Int).Sub(
+		new(big.Int).SetBytes(p.last.AsSlice()),
+		new(big.Int).SetBytes(p.first.AsSlice()),
+	)
+	size.Add(size, big.NewInt(1))
+	if p.bits != 0 {
+		size.Rsh(size, uint(p.first.BitLen()-p.bits))
+	}
+	if hasGateway {
+		size.Sub(size, big.NewInt(1))
+	}
+	if size.IsUint64() {
+		u.Total = size.Uint64()
+	} else {
+		u.Total = math.MaxUint64
+	}
+	return u
+}
+
+// NextFree returns up to n free IP addresses in ascending order. The gateway
+// is never returned, as well as the subnet containing the gateway if the pool
+// is split into subnets. It returns addresses of the free subnets in that case.
+func (p IPBlockPool) NextFree(n int) []netip.Addr {
+	var res []netip.Addr
+	gateway := p.key(p.gateway)
+	isFree := func(addr netip.Addr) bool {
+		// Addresses which are absent in the pool's statuses are free as well.
+		s := p.statuses[addr]
+		return addr != gateway && (s == "" || s == IPAddressStatusFree)
+	}
+
+	if p.block.ListType == IpBlockListTypeSet {
+		for _, addr := range p.set {
+			if len(res) == n {
+				break
+			}
+			if isFree(addr) {
+				res = append(res, addr)
+			}
+		}
+		return res
+	}
+
+	for addr := p.first; addr.IsValid() && !p.last.Less(addr) && len(res) < n; addr = p.next(addr) {
+		if isFree(addr) {
+			res = append(res, addr)
+		}
+	}
+	return res
+}
+
+// NextFreeSubnets returns up to n free subnets in ascending order. Each subnet
+// consists of a single address if the pool isn't split into subnets.
+func (p IPBlockPool) NextFreeSubnets(n int) []netip.Prefix {
+	addrs := p.NextFree(n)
+	res := make([]netip.Prefix, 0, len(addrs))
+	for _, addr := range addrs {
+		bits := p.bits
+		if bits == 0 {
+			bits = addr.BitLen()
+		}
+		res = append(res, netip.PrefixFrom(addr, bits))
+	}
+	return res
+}
+
+// Pool fetches the specified IP block with all its IP addresses and creates
+// a pool for it.
+func (s *IPBlocksService) Pool(ctx context.Context, id int) (IPBlockPool, error) {
+	block, err := s.Get(ctx, id)
+	if err != nil {
+		return IPBlockPool{}, err
+	}
+
+	resp, err := s.IPAddresses(ctx, id)
+	if err != nil {
+		return IPBlockPool{}, err
+	}
+
+	ips := []IPBlockIPAddress{}
+	for {
+		ips = append(ips, resp.Data...)
+		if !resp.Next(ctx) {
+			break
+		}
+	}
+	if resp.Err() != nil {
+		return IPBlockPool{}, resp.Err()
+	}
+
+	return NewIPBlockPool(block, ips)
+}
@@ -0,0 +1,238 @@
+package solus
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPBlockIPAddress_Status(t *testing.T) {
+	assert.Equal(t, IPAddressStatusFree, IPBlockIPAddress{}.Status())
+	assert.Equal(t, IPAddressStatusReserved, IPBlockIPAddress{IsReserved: true}.Status())
+	assert.Equal(t, IPAddressStatusAssigned, IPBlockIPAddress{ComputeResourceVMID: 1}.Status())
+}
+
+func TestNewIPBlockPool(t *testing.T) {
+	t.Run("IPv4 range", func(t *testing.T) {
+		p, err := NewIPBlockPool(IPBlock{
+			Type:     IPv4,
+			ListType: IpBlockListTypeRange,
+			Gateway:  "192.0.2.1",
+			From:     "192.0.2.1",
+			To:       "192.0.2.10",
+		}, []IPBlockIPAddress{
+			{IP: "192.0.2.2", ComputeResourceVMID: 1},
+			{IP: "192.0.2.3", IsReserved: true},
+			{IP: "192.0.2.4"},
+			{IP: "192.0.2.100", ComputeResourceVMID: 2},
+		})
+		require.NoError(t, err)
+
+		u := p.Utilization()
+		assert.Equal(t, IPBlockUtilization{Total: 9, Assigned: 1, Reserved: 1}, u)
+		assert.Equal(t, uint64(7), u.Free())
+		assert.InDelta(t, 22.22, u.Percent(), 0.01)
+
+		assert.Equal(t, []netip.Addr{
+			netip.MustParseAddr("192.0.2.4"),
+			netip.MustParseAddr("192.0.2.5"),
+		}, p.NextFree(2))
+		assert.Len(t, p.NextFree(100), 7)
+		assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("192.0.2.4/32")}, p.NextFreeSubnets(1))
+
+		s, ok := p.Status(netip.MustParseAddr("192.0.2.3"))
+		assert.True(t, ok)
+		assert.Equal(t, IPAddressStatusReserved, s)
+
+		s, ok = p.Status(netip.MustParseAddr("192.0.2.5"))
+		assert.True(t, ok)
+		assert.Equal(t, IPAddressStatusFree, s)
+
+		_, ok = p.Status(netip.MustParseAddr("192.0.2.100"))
+		assert.False(t, ok)
+	})
+
+	t.Run("gateway", func(t *testing.T) {
+		block := IPBlock{
+			Type:     IPv4,
+			ListType: IpBlockListTypeRange,
+			Gateway:  "192.0.2.1",
+			From:     "192.0.2.1",
+			To:       "192.0.2.5",
+		}
+
+		p, err := NewIPBlockPool(block, []IPBlockIPAddress{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(4), p.Utilization().Free())
+
+		// The gateway is outside the range.
+		block.Gateway = "192.0.2.254"
+		p, err = NewIPBlockPool(block, []IPBlockIPAddress{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), p.Utilization().Free())
+	})
+
+	t.Run("IPv6 range", func(t *testing.T) {
+		p, err := NewIPBlockPool(IPBlock{
+			Type:     IPv6,
+			ListType: IpBlockListTypeRange,
+			Range:    "2001:db8::/120",
+		}, []IPBlockIPAddress{
+			{IP: "2001:db8::", ComputeResourceVMID: 1},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, IPBlockUtilization{Total: 256, Assigned: 1}, p.Utilization())
+		assert.Equal(t, []netip.Addr{netip.MustParseAddr("2001:db8::1")}, p.NextFree(1))
+		assert.True(t, p.Contains(netip.MustParseAddr("2001:db8::ff")))
+		assert.False(t, p.Contains(netip.MustParseAddr("2001:db8::100")))
+	})
+
+	t.Run("IPv6 subnets", func(t *testing.T) {
+		p, err := NewIPBlockPool(IPBlock{
+			Type:     IPv6,
+			ListType: IpBlockListTypeRange,
+			Range:    "2001:db8::/62",
+			Subnet:   64,
+		}, []IPBlockIPAddress{
+			{IP: "2001:db8::", ComputeResourceVMID: 1},
+			{IP: "2001:db8:0:2::", IsReserved: true},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, IPBlockUtilization{Total: 4, Assigned: 1, Reserved: 1}, p.Utilization())
+		assert.Equal(t, []netip.Prefix{
+			netip.MustParsePrefix("2001:db8:0:1::/64"),
+			netip.MustParsePrefix("2001:db8:0:3::/64"),
+		}, p.NextFreeSubnets(5))
+
+		s, ok := p.Status(netip.MustParseAddr("2001:db8::42"))
+		assert.True(t, ok)
+		assert.Equal(t, IPAddressStatusAssigned, s)
+	})
+
+	t.Run("IPv6 subnets with gateway", func(t *testing.T) {
+		p, err := NewIPBlockPool(IPBlock{
+			Type:     IPv6,
+			ListType: IpBlockListTypeRange,
+			Gateway:  "2001:db8::1",
+			Range:    "2001:db8::/48",
+			Subnet:   64,
+		}, []IPBlockIPAddress{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []netip.Prefix{
+			netip.MustParsePrefix("2001:db8:0:1::/64"),
+		}, p.NextFreeSubnets(1))
+		assert.Equal(t, uint64(1<<16-1), p.Utilization().Total)
+	})
+
+	t.Run("huge IPv6 range", func(t *testing.T) {
+		p, err := NewIPBlockPool(IPBlock{
+			Type:     IPv6,
+			ListType: IpBlockListTypeRange,
+			Range:    "2001:db8::/32",
+		}, []IPBlockIPAddress{})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(math.MaxUint64), p.Utilization().Total)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		p, err := NewIPBlockPool(IPBlock{
+			Type:     IPv4,
+			ListType: IpBlockListTypeSet,
+			IPs: []IPBlockIPAddress{
+				{IP: "192.0.2.9"},
+				{IP: "192.0.2.5", ComputeResourceVMID: 1},
+				{IP: "192.0.2.7"},
+			},
+		}, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, IPBlockUtilization{Total: 3, Assigned: 1}, p.Utilization())
+		assert.Equal(t, []netip.Addr{
+			netip.MustParseAddr("192.0.2.7"),
+			netip.MustParseAddr("192.0.2.9"),
+		}, p.NextFree(5))
+		assert.False(t, p.Contains(netip.MustParseAddr("192.0.2.6")))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			block    IPBlock
+			expected string
+		}{
+			"invalid list type": {
+				block:    IPBlock{ListType: "foo"},
+				expected: `unsupported IP block list type "foo"`,
+			},
+			"invalid IP address": {
+				block: IPBlock{
+					ListType: IpBlockListTypeSet,
+					IPs:      []IPBlockIPAddress{{IP: "foo"}},
+				},
+				expected: `invalid IP address "foo": ParseAddr("foo"): unable to parse IP`,
+			},
+			"inverted range": {
+				block: IPBlock{
+					Type:     IPv4,
+					ListType: IpBlockListTypeRange,
+					From:     "192.0.2.10",
+					To:       "192.0.2.1",
+				},
+				expected: "invalid range 192.0.2.10-192.0.2.1",
+			},
+			"invalid IPv6 range": {
+				block: IPBlock{
+					Type:     IPv6,
+					ListType: IpBlockListTypeRange,
+					Range:    "2001:db8::",
+				},
+				expected: `invalid range "2001:db8::": netip.ParsePrefix("2001:db8::"): no '/'`,
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				_, err := NewIPBlockPool(c.block, nil)
+				require.EqualError(t, err, c.expected)
+			})
+		}
+	})
+}
+
+func TestIPBlocksService_Pool(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		switch r.URL.Path {
+		case "/ip_blocks/10":
+			writeResponse(t, w, http.StatusOK, IPBlock{
+				Type:     IPv4,
+				ListType: IpBlockListTypeRange,
+				From:     "192.0.2.1",
+				To:       "192.0.2.2",
+			})
+
+		case "/ip_blocks/10/ips":
+			writeJSON(t, w, http.StatusOK, IPBlockIPAddressesResponse{
+				Data: []IPBlockIPAddress{{IP: "192.0.2.1", ComputeResourceVMID: 1}},
+			})
+
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer s.Close()
+
+	p, err := createTestClient(t, s.URL).IPBlocks.Pool(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, IPBlockUtilization{Total: 2, Assigned: 1}, p.Utilization())
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("192.0.2.2")}, p.NextFree(1))
+}
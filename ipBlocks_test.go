@@ -147,3 +147,42 @@ func TestIPBlocksService_IPAddressDelete(t *testing.T) {
 	err := createTestClient(t, s.URL).IPBlocks.IPAddressDelete(context.Background(), 10)
 	require.NoError(t, err)
 }
+
+func TestIPBlocksService_IPAddresses(t *testing.T) {
+	expected := IPBlockIPAddressesResponse{
+		Data: []IPBlockIPAddress{
+			fakeIPBlockIPAddress,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ip_blocks/10/ips", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).IPBlocks.IPAddresses(context.Background(), 10)
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
+func TestIPBlocksService_IPAddressReserve(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ip_blocks/10/ips", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, ipBlockIPAddressCreateRequest{
+			IP:         "192.0.2.2",
+			IsReserved: true,
+		})
+
+		writeResponse(t, w, http.StatusCreated, fakeIPBlockIPAddress)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).IPBlocks.IPAddressReserve(context.Background(), 10, "192.0.2.2")
+	require.NoError(t, err)
+	require.Equal(t, fakeIPBlockIPAddress, actual)
+}
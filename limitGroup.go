@@ -1,5 +1,13 @@
 package solus
 
+import (
+	"context"
+	"fmt"
+)
+
+// LimitGroupsService handles all available methods with limit groups.
+type LimitGroupsService service
+
 // LimitGroup represent limit group.
 type LimitGroup struct {
 	ID                    int             `json:"id"`
@@ -16,3 +24,67 @@ type LimitGroupLimit struct {
 	Limit     int  `json:"limit"`
 	IsEnabled bool `json:"is_enabled"`
 }
+
+// LimitGroupRequest represents available properties for creating new or
+// updating existing limit group.
+type LimitGroupRequest struct {
+	Name                  string          `json:"name"`
+	VirtualServers        LimitGroupLimit `json:"vms"`
+	RunningVirtualServers LimitGroupLimit `json:"running_vms"`
+	AdditionalIPs         LimitGroupLimit `json:"additional_ips"`
+}
+
+// LimitGroupsResponse represents paginated list of limit groups.
+// This cursor can be used for iterating over all available limit groups.
+type LimitGroupsResponse struct {
+	paginatedResponse
+
+	Data []LimitGroup `json:"data"`
+}
+
+type limitGroupResponse struct {
+	Data LimitGroup `json:"data"`
+}
+
+// List lists limit groups.
+func (s *LimitGroupsService) List(ctx context.Context) (LimitGroupsResponse, error) {
+	resp := LimitGroupsResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, "limit_groups", &resp)
+}
+
+// Get gets specified limit group.
+func (s *LimitGroupsService) Get(ctx context.Context, id int) (LimitGroup, error) {
+	var resp limitGroupResponse
+	return resp.Data, s.client.get(ctx, fmt.Sprintf("limit_groups/%d", id), &resp)
+}
+
+// Create creates new limit group.
+func (s *LimitGroupsService) Create(ctx context.Context, data LimitGroupRequest) (LimitGroup, error) {
+	var resp limitGroupResponse
+	return resp.Data, s.client.create(ctx, "limit_groups", data, &resp)
+}
+
+// Update updates specified limit group.
+func (s *LimitGroupsService) Update(ctx context.Context, id int, data LimitGroupRequest) (LimitGroup, error) {
+	var resp limitGroupResponse
+	return resp.Data, s.client.update(ctx, fmt.Sprintf("limit_groups/%d", id), data, &resp)
+}
+
+// Delete deletes specified limit group.
+func (s *LimitGroupsService) Delete(ctx context.Context, id int) error {
+	return s.client.syncDelete(ctx, fmt.Sprintf("limit_groups/%d", id))
+}
+
+// AssignUsers assigns the users to specified limit group.
+func (s *LimitGroupsService) AssignUsers(ctx context.Context, id int, userIDs ...int) error {
+	for _, userID := range userIDs {
+		if _, err := s.client.Users.Update(ctx, userID, UserUpdateRequest{LimitGroupID: id}); err != nil {
+			return fmt.Errorf("assign user %d: %w", userID, err)
+		}
+	}
+	return nil
+}
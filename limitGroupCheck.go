@@ -0,0 +1,155 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+)
+
+// LimitName represents names of limits in a limit group.
+type LimitName string
+
+const (
+	// LimitNameVirtualServers a limit of total number of user's virtual servers.
+	LimitNameVirtualServers LimitName = "vms"
+
+	// LimitNameRunningVirtualServers a limit of number of user's running virtual
+	// servers.
+	LimitNameRunningVirtualServers LimitName = "running_vms"
+
+	// LimitNameAdditionalIPs a limit of number of additional IP addresses
+	// attached to user's virtual servers.
+	LimitNameAdditionalIPs LimitName = "additional_ips"
+)
+
+// LimitExceededError indicates a planned action exceeds a user's limit.
+type LimitExceededError struct {
+	LimitGroupID int
+	Name         LimitName
+	Limit        int
+	Usage        int
+	Requested    int
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf(
+		"limit %q of limit group %d is exceeded: %d of %d is used, %d more is requested",
+		e.Name,
+		e.LimitGroupID,
+		e.Usage,
+		e.Limit,
+		e.Requested,
+	)
+}
+
+// LimitGroupUsage represents user's usage of limit group's limits.
+type LimitGroupUsage struct {
+	VirtualServers        int
+	RunningVirtualServers int
+	AdditionalIPs         int
+}
+
+// CheckVirtualServerCreate checks the specified user may create a virtual server
+// without exceeding the user's limit group. Limit group from settings is used if
+// the user doesn't belong to any.
+// *LimitExceededError is returned if any of the limits will be exceeded. The
+// new virtual server is considered as running.
+func (s *LimitGroupsService) CheckVirtualServerCreate(
+	ctx context.Context,
+	userID int,
+	data VirtualServerCreateRequest,
+) error {
+	user, err := s.client.Users.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	lg, err := s.userLimitGroup(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	usage, err := s.Usage(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	additionalIPs := 0
+	if data.AdditionalIPCount != nil {
+		additionalIPs += *data.AdditionalIPCount
+	}
+	if data.AdditionalIPv6Count != nil {
+		additionalIPs += *data.AdditionalIPv6Count
+	}
+
+	checks := []struct {
+		name      LimitName
+		limit     LimitGroupLimit
+		usage     int
+		requested int
+	}{
+		{LimitNameVirtualServers, lg.VirtualServers, usage.VirtualServers, 1},
+		{LimitNameRunningVirtualServers, lg.RunningVirtualServers, usage.RunningVirtualServers, 1},
+		{LimitNameAdditionalIPs, lg.AdditionalIPs, usage.AdditionalIPs, additionalIPs},
+	}
+	for _, c := range checks {
+		if !c.limit.IsEnabled || c.requested == 0 {
+			continue
+		}
+
+		if c.usage+c.requested > c.limit.Limit {
+			return &LimitExceededError{
+				LimitGroupID: lg.ID,
+				Name:         c.name,
+				Limit:        c.limit.Limit,
+				Usage:        c.usage,
+				Requested:    c.requested,
+			}
+		}
+	}
+	return nil
+}
+
+// userLimitGroup returns the user's limit group, or limit group from settings
+// if the user doesn't belong to any.
+func (s *LimitGroupsService) userLimitGroup(ctx context.Context, user User) (LimitGroup, error) {
+	if user.LimitGroup.ID != 0 {
+		return user.LimitGroup, nil
+	}
+
+	settings, err := s.client.Settings.Get(ctx)
+	if err != nil {
+		return LimitGroup{}, err
+	}
+	return settings.LimitGroup, nil
+}
+
+// Usage calculates the user's usage of limit group's limits by iterating over
+// all user's virtual servers.
+func (s *LimitGroupsService) Usage(ctx context.Context, userID int) (LimitGroupUsage, error) {
+	resp, err := s.client.VirtualServers.List(ctx, (&FilterVirtualServers{}).ByUserID(userID))
+	if err != nil {
+		return LimitGroupUsage{}, err
+	}
+
+	var usage LimitGroupUsage
+	for {
+		for _, vs := range resp.Data {
+			usage.VirtualServers++
+			if vs.Status == VirtualServerStatusStarted {
+				usage.RunningVirtualServers++
+			}
+			for _, ip := range vs.IPs {
+				if !ip.IsPrimary {
+					usage.AdditionalIPs++
+				}
+			}
+		}
+		if !resp.Next(ctx) {
+			break
+		}
+	}
+	if resp.Err() != nil {
+		return LimitGroupUsage{}, resp.Err()
+	}
+	return usage, nil
+}
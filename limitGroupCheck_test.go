@@ -0,0 +1,174 @@
+package solus
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func limitGroupCheckTestHandler(t *testing.T, user User) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		switch r.URL.Path {
+		case "/users/10":
+			writeResponse(t, w, http.StatusOK, user)
+
+		case "/settings":
+			writeResponse(t, w, http.StatusOK, Settings{LimitGroup: LimitGroup{
+				ID:             2,
+				VirtualServers: LimitGroupLimit{Limit: 2, IsEnabled: true},
+			}})
+
+		case "/servers":
+			assert.Equal(t, "10", r.URL.Query().Get("filter[user_id]"))
+			writeJSON(t, w, http.StatusOK, VirtualServersResponse{
+				Data: []VirtualServer{
+					{
+						ID:     1,
+						Status: VirtualServerStatusStarted,
+						IPs: []IPBlockIPAddress{
+							{IP: "192.0.2.1", IsPrimary: true},
+							{IP: "192.0.2.2"},
+						},
+					},
+					{ID: 2, Status: VirtualServerStatusStopped},
+				},
+			})
+
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestLimitGroupsService_Usage(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, limitGroupCheckTestHandler(t, User{ID: 10}))
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).LimitGroups.Usage(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, LimitGroupUsage{
+			VirtualServers:        2,
+			RunningVirtualServers: 1,
+			AdditionalIPs:         1,
+		}, actual)
+	})
+
+	t.Run("failed to fetch next page", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			writeJSON(t, w, http.StatusOK, VirtualServersResponse{
+				paginatedResponse: paginatedResponse{
+					Links: ResponseLinks{Next: r.URL.Path + "?page=2"},
+					Meta:  ResponseMeta{CurrentPage: 1, LastPage: 2},
+				},
+				Data: []VirtualServer{{ID: 1, Status: VirtualServerStatusStarted}},
+			})
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).LimitGroups.Usage(context.Background(), 10)
+		require.Error(t, err)
+		require.Equal(t, LimitGroupUsage{}, actual)
+	})
+}
+
+func TestLimitGroupsService_CheckVirtualServerCreate(t *testing.T) {
+	one := 1
+
+	cc := map[string]struct {
+		limitGroup LimitGroup
+		request    VirtualServerCreateRequest
+		expected   *LimitExceededError
+	}{
+		"fits": {
+			limitGroup: LimitGroup{
+				ID:                    1,
+				VirtualServers:        LimitGroupLimit{Limit: 3, IsEnabled: true},
+				RunningVirtualServers: LimitGroupLimit{Limit: 2, IsEnabled: true},
+				AdditionalIPs:         LimitGroupLimit{Limit: 1, IsEnabled: true},
+			},
+		},
+		"disabled limits": {
+			limitGroup: LimitGroup{
+				ID:             1,
+				VirtualServers: LimitGroupLimit{Limit: 1},
+			},
+			request: VirtualServerCreateRequest{AdditionalIPCount: &one},
+		},
+		"running servers": {
+			limitGroup: LimitGroup{
+				ID:                    1,
+				RunningVirtualServers: LimitGroupLimit{Limit: 1, IsEnabled: true},
+			},
+			expected: &LimitExceededError{
+				LimitGroupID: 1,
+				Name:         LimitNameRunningVirtualServers,
+				Limit:        1,
+				Usage:        1,
+				Requested:    1,
+			},
+		},
+		"additional IPs": {
+			limitGroup: LimitGroup{
+				ID:            1,
+				AdditionalIPs: LimitGroupLimit{Limit: 1, IsEnabled: true},
+			},
+			request: VirtualServerCreateRequest{AdditionalIPCount: &one},
+			expected: &LimitExceededError{
+				LimitGroupID: 1,
+				Name:         LimitNameAdditionalIPs,
+				Limit:        1,
+				Usage:        1,
+				Requested:    1,
+			},
+		},
+		"default limit group": {
+			expected: &LimitExceededError{
+				LimitGroupID: 2,
+				Name:         LimitNameVirtualServers,
+				Limit:        2,
+				Usage:        2,
+				Requested:    1,
+			},
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			s := startTestServer(t, limitGroupCheckTestHandler(t, User{ID: 10, LimitGroup: c.limitGroup}))
+			defer s.Close()
+
+			err := createTestClient(t, s.URL).LimitGroups.CheckVirtualServerCreate(context.Background(), 10, c.request)
+			if c.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var actual *LimitExceededError
+			require.True(t, errors.As(err, &actual))
+			require.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func TestLimitExceededError_Error(t *testing.T) {
+	err := &LimitExceededError{
+		LimitGroupID: 1,
+		Name:         LimitNameVirtualServers,
+		Limit:        2,
+		Usage:        2,
+		Requested:    1,
+	}
+	require.EqualError(t, err, `limit "vms" of limit group 1 is exceeded: 2 of 2 is used, 1 more is requested`)
+}
@@ -0,0 +1,135 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fakeLimitGroup = LimitGroup{
+	ID:                    1,
+	Name:                  "fake limit group",
+	VirtualServers:        LimitGroupLimit{Limit: 3, IsEnabled: true},
+	RunningVirtualServers: LimitGroupLimit{Limit: 2, IsEnabled: true},
+	AdditionalIPs:         LimitGroupLimit{Limit: 1, IsEnabled: true},
+}
+
+func TestLimitGroupsService_List(t *testing.T) {
+	expected := LimitGroupsResponse{
+		Data: []LimitGroup{
+			fakeLimitGroup,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/limit_groups", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).LimitGroups.List(context.Background())
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
+func TestLimitGroupsService_Get(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/limit_groups/10", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeLimitGroup)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).LimitGroups.Get(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeLimitGroup, actual)
+}
+
+func TestLimitGroupsService_Create(t *testing.T) {
+	data := LimitGroupRequest{
+		Name:           "name",
+		VirtualServers: LimitGroupLimit{Limit: 3, IsEnabled: true},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/limit_groups", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusCreated, fakeLimitGroup)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).LimitGroups.Create(context.Background(), data)
+	require.NoError(t, err)
+	require.Equal(t, fakeLimitGroup, actual)
+}
+
+func TestLimitGroupsService_Update(t *testing.T) {
+	data := LimitGroupRequest{
+		Name:          "name",
+		AdditionalIPs: LimitGroupLimit{Limit: 1, IsEnabled: true},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/limit_groups/10", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusOK, fakeLimitGroup)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).LimitGroups.Update(context.Background(), 10, data)
+	require.NoError(t, err)
+	require.Equal(t, fakeLimitGroup, actual)
+}
+
+func TestLimitGroupsService_Delete(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/limit_groups/10", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestClient(t, s.URL).LimitGroups.Delete(context.Background(), 10)
+	require.NoError(t, err)
+}
+
+func TestLimitGroupsService_AssignUsers(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		var assigned []string
+
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assertRequestBody(t, r, UserUpdateRequest{LimitGroupID: 10})
+			assigned = append(assigned, r.URL.Path)
+
+			writeResponse(t, w, http.StatusOK, fakeUser)
+		})
+		defer s.Close()
+
+		err := createTestClient(t, s.URL).LimitGroups.AssignUsers(context.Background(), 10, 1, 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{"/users/1", "/users/2"}, assigned)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		defer s.Close()
+
+		err := createTestClient(t, s.URL).LimitGroups.AssignUsers(context.Background(), 10, 1, 2)
+		require.EqualError(t, err, "assign user 1: HTTP PUT users/1 returns 400 status code")
+	})
+}
@@ -2,6 +2,7 @@ package solus
 
 import (
 	"context"
+	"fmt"
 )
 
 // PermissionsService handles all available methods with permissions.
@@ -30,3 +31,50 @@ func (s *PermissionsService) List(ctx context.Context) (PermissionResponse, erro
 	}
 	return resp, s.client.list(ctx, "permissions", &resp)
 }
+
+// GetByName gets specified permission by name.
+func (s *PermissionsService) GetByName(ctx context.Context, name string) (Permission, error) {
+	ids, err := s.IDsByNames(ctx, name)
+	if err != nil {
+		return Permission{}, err
+	}
+	return Permission{ID: ids[0], Name: name}, nil
+}
+
+// IDsByNames resolves permission names to their IDs. The IDs are returned in
+// the same order as the names. It fails if any of the permissions isn't found.
+func (s *PermissionsService) IDsByNames(ctx context.Context, names ...string) ([]int, error) {
+	resp, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]int{}
+	for {
+		for _, p := range resp.Data {
+			byName[p.Name] = p.ID
+		}
+		if !resp.Next(ctx) {
+			break
+		}
+	}
+	if resp.Err() != nil {
+		return nil, resp.Err()
+	}
+
+	ids := make([]int, 0, len(names))
+	var missed []string
+	for _, name := range names {
+		id, ok := byName[name]
+		if !ok {
+			missed = append(missed, name)
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	if len(missed) > 0 {
+		return nil, fmt.Errorf("permissions %q not found", missed)
+	}
+	return ids, nil
+}
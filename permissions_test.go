@@ -28,3 +28,42 @@ func TestPermissionsService_List(t *testing.T) {
 	actual.service = nil
 	require.Equal(t, expected, actual)
 }
+
+func TestPermissionsService_IDsByNames(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/permissions", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, PermissionResponse{
+			Data: []Permission{
+				{ID: 1, Name: "foo"},
+				{ID: 2, Name: "bar"},
+			},
+		})
+	})
+	defer s.Close()
+
+	t.Run("positive", func(t *testing.T) {
+		actual, err := createTestClient(t, s.URL).Permission.IDsByNames(context.Background(), "bar", "foo")
+		require.NoError(t, err)
+		require.Equal(t, []int{2, 1}, actual)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := createTestClient(t, s.URL).Permission.IDsByNames(context.Background(), "foo", "fizz", "buzz")
+		require.EqualError(t, err, `permissions ["fizz" "buzz"] not found`)
+	})
+}
+
+func TestPermissionsService_GetByName(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, PermissionResponse{
+			Data: []Permission{fakePermission},
+		})
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Permission.GetByName(context.Background(), fakePermission.Name)
+	require.NoError(t, err)
+	require.Equal(t, fakePermission, actual)
+}
@@ -168,6 +168,38 @@ func (s *TrafficPlanLimit) setDefault() {
 	}
 }
 
+// Bytes returns the limit value in bytes.
+// Returns 0 if the limit has unknown unit.
+func (s TrafficPlanLimit) Bytes() int64 {
+	unit := s.Unit
+	if unit == "" {
+		unit = TrafficPlanLimitUnitKiB
+	}
+
+	multiplier, ok := map[TrafficPlanLimitUnit]int64{
+		TrafficPlanLimitUnitKiB: 1 << 10,
+		TrafficPlanLimitUnitMiB: 1 << 20,
+		TrafficPlanLimitUnitGiB: 1 << 30,
+		TrafficPlanLimitUnitTiB: 1 << 40,
+		TrafficPlanLimitUnitPiB: 1 << 50,
+	}[unit]
+	if !ok {
+		return 0
+	}
+	return int64(s.Limit) * multiplier
+}
+
+// Percent returns how many percents of the limit are consumed by specified
+// amount of bytes. The result may be greater than 100 if the limit is exceeded.
+// Returns 0 if the limit is disabled or has zero value.
+func (s TrafficPlanLimit) Percent(bytes int64) float64 {
+	limit := s.Bytes()
+	if !s.IsEnabled || limit == 0 {
+		return 0
+	}
+	return float64(bytes) / float64(limit) * 100
+}
+
 // UnitPlanLimit represents generic units limit.
 type UnitPlanLimit struct {
 	IsEnabled bool          `json:"is_enabled"`
@@ -66,6 +66,42 @@ func TestTrafficPlanLimit_setDefault(t *testing.T) {
 	}
 }
 
+func TestTrafficPlanLimit_Bytes(t *testing.T) {
+	testCases := map[string]struct {
+		given    TrafficPlanLimit
+		expected int64
+	}{
+		"empty unit":   {TrafficPlanLimit{Limit: 2}, 2048},
+		"MiB":          {TrafficPlanLimit{Limit: 3, Unit: TrafficPlanLimitUnitMiB}, 3 << 20},
+		"TiB":          {TrafficPlanLimit{Limit: 1, Unit: TrafficPlanLimitUnitTiB}, 1 << 40},
+		"unknown unit": {TrafficPlanLimit{Limit: 1, Unit: "foo"}, 0},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.given.Bytes())
+		})
+	}
+}
+
+func TestTrafficPlanLimit_Percent(t *testing.T) {
+	testCases := map[string]struct {
+		given    TrafficPlanLimit
+		expected float64
+	}{
+		"disabled":   {TrafficPlanLimit{Limit: 1, Unit: TrafficPlanLimitUnitGiB}, 0},
+		"zero limit": {TrafficPlanLimit{IsEnabled: true, Unit: TrafficPlanLimitUnitGiB}, 0},
+		"half":       {TrafficPlanLimit{IsEnabled: true, Limit: 2, Unit: TrafficPlanLimitUnitGiB}, 50},
+		"exceeded":   {TrafficPlanLimit{IsEnabled: true, Limit: 512, Unit: TrafficPlanLimitUnitMiB}, 200},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.given.Percent(1<<30))
+		})
+	}
+}
+
 func TestUnitPlanLimit_setDefault(t *testing.T) {
 	testCases := map[string]*UnitPlanLimit{
 		"empty":     {},
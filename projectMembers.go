@@ -0,0 +1,109 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/mail"
+)
+
+// ProjectMemberStatus represents a status of a project's member.
+type ProjectMemberStatus string
+
+const (
+	// ProjectMemberStatusInvited indicates the user is invited but hasn't
+	// accepted the invitation yet.
+	ProjectMemberStatusInvited ProjectMemberStatus = "invited"
+
+	// ProjectMemberStatusAccepted indicates the user has accepted the invitation.
+	ProjectMemberStatusAccepted ProjectMemberStatus = "accepted"
+)
+
+// ProjectMember represents a member of a project.
+// User is empty for invited members which aren't registered yet.
+type ProjectMember struct {
+	ID      int                 `json:"id"`
+	Email   string              `json:"email"`
+	Status  ProjectMemberStatus `json:"status"`
+	IsOwner bool                `json:"is_owner"`
+	User    User                `json:"user"`
+}
+
+// ProjectMembersResponse represents paginated list of project's members.
+// This cursor can be used for iterating over all available project's members.
+type ProjectMembersResponse struct {
+	paginatedResponse
+
+	Data []ProjectMember `json:"data"`
+}
+
+type projectMemberInviteRequest struct {
+	Email string `json:"email"`
+}
+
+type projectOwnershipTransferRequest struct {
+	UserID int `json:"user_id"`
+}
+
+// Members lists members of the specified project including invited ones.
+func (s *ProjectsService) Members(ctx context.Context, id int) (ProjectMembersResponse, error) {
+	resp := ProjectMembersResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, fmt.Sprintf("projects/%d/members", id), &resp)
+}
+
+// InviteMember invites a user with specified email to the project. The invitation
+// is sent by email, so the user doesn't have to be registered.
+func (s *ProjectsService) InviteMember(ctx context.Context, id int, email string) (ProjectMember, error) {
+	if _, err := mail.ParseAddress(email); err != nil {
+		return ProjectMember{}, fmt.Errorf("invalid email %q: %w", email, err)
+	}
+
+	var resp struct {
+		Data ProjectMember `json:"data"`
+	}
+	return resp.Data, s.client.create(
+		ctx,
+		fmt.Sprintf("projects/%d/members", id),
+		projectMemberInviteRequest{Email: email},
+		&resp,
+	)
+}
+
+// RemoveMember removes the member from the project or revokes the invitation
+// if the member hasn't accepted it yet.
+func (s *ProjectsService) RemoveMember(ctx context.Context, id, memberID int) error {
+	return s.client.syncDelete(ctx, fmt.Sprintf("projects/%d/members/%d", id, memberID))
+}
+
+// LeaveProject removes the current user from the specified project.
+// Project's owner can't leave the project, the ownership should be transferred
+// first.
+func (s *ProjectsService) LeaveProject(ctx context.Context, id int) error {
+	return s.client.syncPost(ctx, fmt.Sprintf("projects/%d/leave", id))
+}
+
+// TransferOwnership transfers ownership of the project to the specified user.
+// The user should be an accepted member of the project.
+func (s *ProjectsService) TransferOwnership(ctx context.Context, id, userID int) (Project, error) {
+	path := fmt.Sprintf("projects/%d/transfer_ownership", id)
+	body, code, err := s.client.request(
+		ctx,
+		http.MethodPost,
+		path,
+		withBody(projectOwnershipTransferRequest{UserID: userID}),
+	)
+	if err != nil {
+		return Project{}, err
+	}
+
+	if code != http.StatusOK {
+		return Project{}, newHTTPError(http.MethodPost, path, code, body)
+	}
+
+	var resp projectResponse
+	return resp.Data, unmarshal(body, &resp)
+}
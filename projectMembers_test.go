@@ -0,0 +1,134 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectsService_Members(t *testing.T) {
+	expected := ProjectMembersResponse{
+		Data: []ProjectMember{
+			fakeProjectMember,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/10/members", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Projects.Members(context.Background(), 10)
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
+func TestProjectsService_InviteMember(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/projects/10/members", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assertRequestBody(t, r, projectMemberInviteRequest{Email: "member@example.com"})
+
+			writeResponse(t, w, http.StatusCreated, fakeProjectMember)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Projects.InviteMember(context.Background(), 10, "member@example.com")
+		require.NoError(t, err)
+		require.Equal(t, fakeProjectMember, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		_, err := createTestClient(t, "").Projects.InviteMember(context.Background(), 10, "member")
+		require.EqualError(t, err, `invalid email "member": mail: missing '@' or angle-addr`)
+	})
+}
+
+func TestProjectsService_RemoveMember(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/10/members/20", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestClient(t, s.URL).Projects.RemoveMember(context.Background(), 10, 20)
+	require.NoError(t, err)
+}
+
+func TestProjectsService_LeaveProject(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/projects/10/leave", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+		defer s.Close()
+
+		err := createTestClient(t, s.URL).Projects.LeaveProject(context.Background(), 10)
+		require.NoError(t, err)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to make request", func(t *testing.T) {
+			asserter, addr := startBrokenTestServer(t)
+			err := createTestClient(t, addr).Projects.LeaveProject(context.Background(), 10)
+			asserter(t, http.MethodPost, "/projects/10/leave", err)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			})
+			defer s.Close()
+
+			err := createTestClient(t, s.URL).Projects.LeaveProject(context.Background(), 10)
+			require.EqualError(t, err, "HTTP POST projects/10/leave returns 403 status code")
+		})
+	})
+}
+
+func TestProjectsService_TransferOwnership(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/projects/10/transfer_ownership", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assertRequestBody(t, r, projectOwnershipTransferRequest{UserID: 20})
+
+			writeResponse(t, w, http.StatusOK, fakeProject)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Projects.TransferOwnership(context.Background(), 10, 20)
+		require.NoError(t, err)
+		require.Equal(t, fakeProject, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to make request", func(t *testing.T) {
+			asserter, addr := startBrokenTestServer(t)
+			_, err := createTestClient(t, addr).Projects.TransferOwnership(context.Background(), 10, 20)
+			asserter(t, http.MethodPost, "/projects/10/transfer_ownership", err)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Projects.TransferOwnership(context.Background(), 10, 20)
+			require.EqualError(t, err, "HTTP POST projects/10/transfer_ownership returns 400 status code")
+		})
+	})
+}
@@ -70,3 +70,13 @@ func (s *ProjectsService) Delete(ctx context.Context, id int) error {
 type projectResponse struct {
 	Data Project `json:"data"`
 }
+
+// SSHKeys lists SSH keys of the specified project.
+func (s *ProjectsService) SSHKeys(ctx context.Context, id int) (SSHKeysResponse, error) {
+	resp := SSHKeysResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, fmt.Sprintf("projects/%d/ssh_keys", id), &resp)
+}
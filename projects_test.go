@@ -102,3 +102,24 @@ func TestProjectsService_Delete(t *testing.T) {
 	err := createTestClient(t, s.URL).Projects.Delete(context.Background(), 10)
 	require.NoError(t, err)
 }
+
+func TestProjectsService_SSHKeys(t *testing.T) {
+	expected := SSHKeysResponse{
+		Data: []SSHKey{
+			fakeSSHKey,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/10/ssh_keys", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Projects.SSHKeys(context.Background(), 10)
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
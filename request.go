@@ -20,8 +20,13 @@ var errMaxRetriesReached = errors.New("exceeded retry limit")
 type retryFunc func(attempt int) (retry bool, err error)
 
 type requestOpts struct {
-	params map[string][]string
-	body   interface{}
+	params      map[string][]string
+	body        interface{}
+	credentials *Credentials
+
+	// skipAuthentication a request doesn't trigger lazy authentication and
+	// is sent without credentials.
+	skipAuthentication bool
 }
 
 type requestOption func(*requestOpts)
@@ -47,8 +52,33 @@ func withBody(b interface{}) requestOption {
 	}
 }
 
-func (c *Client) create(ctx context.Context, path string, data, resp interface{}) error {
-	body, code, err := c.request(ctx, http.MethodPost, path, withBody(data))
+// withCredentials makes a request with specified credentials instead of the
+// client's ones.
+func withCredentials(credentials Credentials) requestOption {
+	return func(o *requestOpts) {
+		o.credentials = &credentials
+	}
+}
+
+// withoutAuthentication makes a request to a public endpoint which doesn't
+// require authentication, so the lazy authentication isn't triggered by it and
+// the client's credentials aren't sent.
+func withoutAuthentication() requestOption {
+	return func(o *requestOpts) {
+		o.skipAuthentication = true
+	}
+}
+
+func newRequestOpts(opts ...requestOption) requestOpts {
+	reqOpts := requestOpts{}
+	for _, o := range opts {
+		o(&reqOpts)
+	}
+	return reqOpts
+}
+
+func (c *Client) create(ctx context.Context, path string, data, resp interface{}, opts ...requestOption) error {
+	body, code, err := c.request(ctx, http.MethodPost, path, append([]requestOption{withBody(data)}, opts...)...)
 	if err != nil {
 		return err
 	}
@@ -146,6 +176,18 @@ func (c *Client) syncDelete(ctx context.Context, path string) error {
 	return nil
 }
 
+func (c *Client) syncPost(ctx context.Context, path string, opts ...requestOption) error {
+	body, code, err := c.request(ctx, http.MethodPost, path, opts...)
+	if err != nil {
+		return err
+	}
+
+	if code != http.StatusNoContent {
+		return newHTTPError(http.MethodPost, path, code, body)
+	}
+	return nil
+}
+
 func (c *Client) asyncPost(ctx context.Context, path string, opts ...requestOption) (Task, error) {
 	body, code, err := c.request(ctx, http.MethodPost, path, opts...)
 	if err != nil {
@@ -169,6 +211,12 @@ func (c *Client) asyncPost(ctx context.Context, path string, opts ...requestOpti
 }
 
 func (c *Client) request(ctx context.Context, method, path string, opts ...requestOption) ([]byte, int, error) {
+	if !newRequestOpts(opts...).skipAuthentication {
+		if err := c.authenticateLazily(); err != nil {
+			return nil, 0, err
+		}
+	}
+
 	req, err := c.buildRequest(ctx, method, path, opts...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to build HTTP request: %w", err)
@@ -212,10 +260,7 @@ func (c *Client) request(ctx context.Context, method, path string, opts ...reque
 }
 
 func (c *Client) buildRequest(ctx context.Context, method, path string, opts ...requestOption) (*http.Request, error) {
-	reqOpts := requestOpts{}
-	for _, o := range opts {
-		o(&reqOpts)
-	}
+	reqOpts := newRequestOpts(opts...)
 
 	var (
 		bodyByte []byte
@@ -240,11 +285,20 @@ func (c *Client) buildRequest(ctx context.Context, method, path string, opts ...
 		return nil, err
 	}
 
+	c.credentialsMu.RLock()
 	for k, values := range c.Headers {
 		for _, v := range values {
 			req.Header.Add(k, v)
 		}
 	}
+	c.credentialsMu.RUnlock()
+
+	switch {
+	case reqOpts.credentials != nil:
+		req.Header.Set("Authorization", authorizationHeader(*reqOpts.credentials))
+	case reqOpts.skipAuthentication:
+		req.Header.Del("Authorization")
+	}
 
 	req.Header.Set("User-Agent", c.UserAgent)
 
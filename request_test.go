@@ -827,3 +827,44 @@ func Test_unmarshal(t *testing.T) {
 		assert.EqualError(t, err, `decode "invalid": invalid character 'i' looking for beginning of value`)
 	})
 }
+
+func TestClient_syncPost(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/foo", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assertRequestBody(t, r, map[string]string{"foo": "bar"})
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+		defer s.Close()
+
+		err := createTestClient(t, s.URL).syncPost(
+			context.Background(),
+			"/foo",
+			withBody(map[string]string{"foo": "bar"}),
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to make request", func(t *testing.T) {
+			err := createTestClient(t, "/").syncPost(context.Background(), string([]rune{0x02}))
+			assert.EqualError(
+				t,
+				err,
+				`failed to build HTTP request: parse "\x02": net/url: invalid control character in URL`,
+			)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			defer s.Close()
+
+			err := createTestClient(t, s.URL).syncPost(context.Background(), "/foo")
+			assert.EqualError(t, err, "HTTP POST /foo returns 200 status code")
+		})
+	})
+}
@@ -0,0 +1,63 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+)
+
+// ReverseDNSService handles all available methods with reverse DNS records.
+type ReverseDNSService service
+
+// ReverseDNS represents a reverse DNS (PTR) record of an IP address.
+type ReverseDNS struct {
+	ID     int    `json:"id"`
+	IPID   int    `json:"ip_id"`
+	IP     string `json:"ip"`
+	Domain string `json:"domain"`
+}
+
+// ReverseDNSCreateRequest represents available properties for creating a new
+// reverse DNS record.
+type ReverseDNSCreateRequest struct {
+	IPID int `json:"ip_id"`
+
+	// IP an exact address for the record. Required only for IPv6 addresses,
+	// where the IP address entity is a whole subnet.
+	IP     string `json:"ip,omitempty"`
+	Domain string `json:"domain"`
+}
+
+// ReverseDNSUpdateRequest represents available properties for updating an
+// existing reverse DNS record.
+type ReverseDNSUpdateRequest struct {
+	Domain string `json:"domain"`
+}
+
+type reverseDNSResponse struct {
+	Data ReverseDNS `json:"data"`
+}
+
+// Create creates new reverse DNS record.
+// The record is registered on the DNS server asynchronously by
+// TaskActionReverseDNSRecordRegister task.
+func (s *ReverseDNSService) Create(ctx context.Context, data ReverseDNSCreateRequest) (ReverseDNS, error) {
+	var resp reverseDNSResponse
+	return resp.Data, s.client.create(ctx, "reverse_dns", data, &resp)
+}
+
+// Get gets specified reverse DNS record.
+func (s *ReverseDNSService) Get(ctx context.Context, id int) (ReverseDNS, error) {
+	var resp reverseDNSResponse
+	return resp.Data, s.client.get(ctx, fmt.Sprintf("reverse_dns/%d", id), &resp)
+}
+
+// Update updates specified reverse DNS record.
+func (s *ReverseDNSService) Update(ctx context.Context, id int, data ReverseDNSUpdateRequest) (ReverseDNS, error) {
+	var resp reverseDNSResponse
+	return resp.Data, s.client.patch(ctx, fmt.Sprintf("reverse_dns/%d", id), data, &resp)
+}
+
+// Delete deletes specified reverse DNS record.
+func (s *ReverseDNSService) Delete(ctx context.Context, id int) error {
+	return s.client.syncDelete(ctx, fmt.Sprintf("reverse_dns/%d", id))
+}
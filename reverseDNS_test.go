@@ -0,0 +1,77 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverseDNSService_Create(t *testing.T) {
+	data := ReverseDNSCreateRequest{
+		IPID:   1,
+		IP:     "2001:db8::1",
+		Domain: "example.com",
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/reverse_dns", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusCreated, fakeReverseDNS)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ReverseDNS.Create(context.Background(), data)
+	require.NoError(t, err)
+	require.Equal(t, fakeReverseDNS, actual)
+}
+
+func TestReverseDNSService_Get(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/reverse_dns/10", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeReverseDNS)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ReverseDNS.Get(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeReverseDNS, actual)
+}
+
+func TestReverseDNSService_Update(t *testing.T) {
+	data := ReverseDNSUpdateRequest{
+		Domain: "example.org",
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/reverse_dns/10", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusOK, fakeReverseDNS)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ReverseDNS.Update(context.Background(), 10, data)
+	require.NoError(t, err)
+	require.Equal(t, fakeReverseDNS, actual)
+}
+
+func TestReverseDNSService_Delete(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/reverse_dns/10", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestClient(t, s.URL).ReverseDNS.Delete(context.Background(), 10)
+	require.NoError(t, err)
+}
@@ -3,6 +3,7 @@ package solus
 import (
 	"context"
 	"fmt"
+	"sort"
 )
 
 // RolesService handles all available methods with roles.
@@ -10,10 +11,11 @@ type RolesService service
 
 // Role represents a role.
 type Role struct {
-	ID         int    `json:"id"`
-	Name       string `json:"name"`
-	IsDefault  bool   `json:"is_default"`
-	UsersCount int    `json:"users_count"`
+	ID          int          `json:"id"`
+	Name        string       `json:"name"`
+	IsDefault   bool         `json:"is_default"`
+	UsersCount  int          `json:"users_count"`
+	Permissions []Permission `json:"permissions"`
 }
 
 // RoleCreateRequest represents available properties for creating a new role.
@@ -22,6 +24,12 @@ type RoleCreateRequest struct {
 	Permissions []int  `json:"permissions,omitempty"`
 }
 
+// RoleUpdateRequest represents available properties for updating a role.
+type RoleUpdateRequest struct {
+	Name        string `json:"name"`
+	Permissions []int  `json:"permissions"`
+}
+
 // RolesResponse represents paginated list of roles.
 // This cursor can be used for iterating over all available roles.
 type RolesResponse struct {
@@ -63,11 +71,79 @@ func (s *RolesService) GetByName(ctx context.Context, name string) (Role, error)
 		return Role{}, err
 	}
 
-	for _, role := range roles.Data {
-		if role.Name == name {
-			return role, nil
+	for {
+		for _, role := range roles.Data {
+			if role.Name == name {
+				return role, nil
+			}
+		}
+		if !roles.Next(ctx) {
+			break
 		}
 	}
+	if roles.Err() != nil {
+		return Role{}, roles.Err()
+	}
 
 	return Role{}, fmt.Errorf("failed to get role by name %q: role not found", name)
 }
+
+// Update updates specified role.
+func (s *RolesService) Update(ctx context.Context, id int, data RoleUpdateRequest) (Role, error) {
+	var resp roleResponse
+	return resp.Data, s.client.update(ctx, fmt.Sprintf("roles/%d", id), data, &resp)
+}
+
+// Delete deletes specified role.
+func (s *RolesService) Delete(ctx context.Context, id int) error {
+	return s.client.syncDelete(ctx, fmt.Sprintf("roles/%d", id))
+}
+
+// AssignPermissions grants the permissions to specified role. The role's
+// other permissions are kept.
+func (s *RolesService) AssignPermissions(ctx context.Context, id int, permissionIDs ...int) (Role, error) {
+	return s.changePermissions(ctx, id, func(ids map[int]bool) {
+		for _, pid := range permissionIDs {
+			ids[pid] = true
+		}
+	})
+}
+
+// RevokePermissions revokes the permissions from specified role. The role's
+// other permissions are kept.
+func (s *RolesService) RevokePermissions(ctx context.Context, id int, permissionIDs ...int) (Role, error) {
+	return s.changePermissions(ctx, id, func(ids map[int]bool) {
+		for _, pid := range permissionIDs {
+			delete(ids, pid)
+		}
+	})
+}
+
+func (s *RolesService) changePermissions(ctx context.Context, id int, change func(ids map[int]bool)) (Role, error) {
+	role, err := s.Get(ctx, id)
+	if err != nil {
+		return Role{}, err
+	}
+
+	ids := make(map[int]bool, len(role.Permissions))
+	for _, p := range role.Permissions {
+		ids[p.ID] = true
+	}
+	change(ids)
+
+	data := RoleUpdateRequest{
+		Name:        role.Name,
+		Permissions: make([]int, 0, len(ids)),
+	}
+	for pid := range ids {
+		data.Permissions = append(data.Permissions, pid)
+	}
+	sort.Ints(data.Permissions)
+
+	return s.Update(ctx, id, data)
+}
+
+// Users lists users which have specified role.
+func (s *RolesService) Users(ctx context.Context, id int) (UsersResponse, error) {
+	return s.client.Users.List(ctx, (&FilterUsers{}).ByRoleID(id))
+}
@@ -3,6 +3,7 @@ package solus
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -98,3 +99,134 @@ func TestRolesService_GetByName(t *testing.T) {
 		})
 	})
 }
+
+func TestRolesService_GetByName_Paginated(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/roles", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, http.StatusOK, RolesResponse{
+				paginatedResponse: paginatedResponse{
+					Meta: ResponseMeta{CurrentPage: 2, LastPage: 2},
+				},
+				Data: []Role{{Name: "bar"}},
+			})
+			return
+		}
+
+		q := r.URL.Query()
+		q.Set("page", "2")
+		r.URL.RawQuery = q.Encode()
+
+		writeJSON(t, w, http.StatusOK, RolesResponse{
+			paginatedResponse: paginatedResponse{
+				Links: ResponseLinks{Next: r.URL.String()},
+				Meta:  ResponseMeta{CurrentPage: 1, LastPage: 2},
+			},
+			Data: []Role{{Name: "foo"}},
+		})
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Roles.GetByName(context.Background(), "bar")
+	require.NoError(t, err)
+	require.Equal(t, Role{Name: "bar"}, actual)
+}
+
+func TestRolesService_Update(t *testing.T) {
+	data := RoleUpdateRequest{
+		Name:        "name",
+		Permissions: []int{1, 2},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/roles/10", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusOK, fakeRole)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Roles.Update(context.Background(), 10, data)
+	require.NoError(t, err)
+	require.Equal(t, fakeRole, actual)
+}
+
+func TestRolesService_Delete(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/roles/10", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer s.Close()
+
+	err := createTestClient(t, s.URL).Roles.Delete(context.Background(), 10)
+	require.NoError(t, err)
+}
+
+func rolesPermissionsTestHandler(t *testing.T, expected []int) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/roles/10", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodGet:
+			writeResponse(t, w, http.StatusOK, Role{
+				ID:          10,
+				Name:        "role",
+				Permissions: []Permission{{ID: 1}, {ID: 3}},
+			})
+
+		case http.MethodPut:
+			assertRequestBody(t, r, RoleUpdateRequest{Name: "role", Permissions: expected})
+			writeResponse(t, w, http.StatusOK, fakeRole)
+
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestRolesService_AssignPermissions(t *testing.T) {
+	s := startTestServer(t, rolesPermissionsTestHandler(t, []int{1, 2, 3}))
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Roles.AssignPermissions(context.Background(), 10, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, fakeRole, actual)
+}
+
+func TestRolesService_RevokePermissions(t *testing.T) {
+	s := startTestServer(t, rolesPermissionsTestHandler(t, []int{3}))
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Roles.RevokePermissions(context.Background(), 10, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, fakeRole, actual)
+}
+
+func TestRolesService_Users(t *testing.T) {
+	expected := UsersResponse{
+		Data: []User{
+			fakeUser,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assertRequestQuery(t, r, url.Values{
+			"filter[role]": []string{"10"},
+		})
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Roles.Users(context.Background(), 10)
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
@@ -2,6 +2,8 @@ package solus
 
 import (
 	"context"
+	"fmt"
+	"time"
 )
 
 // ServersMigrationsService handles all available methods with server's migrations.
@@ -24,10 +26,113 @@ type ServersMigrationRequest struct {
 	Servers                      []int `json:"servers"`
 }
 
+// ServersMigrationsResponse represents paginated list of server's migrations.
+// This cursor can be used for iterating over all available server's migrations.
+type ServersMigrationsResponse struct {
+	paginatedResponse
+
+	Data []ServersMigration `json:"data"`
+}
+
+// ServersMigrationResult represents a result of a single server migration.
+type ServersMigrationResult struct {
+	ServerID int
+	Task     Task
+
+	// Err is not nil if the server migration task isn't finished successfully.
+	Err error
+
+	// Elapsed a time elapsed since the Wait call until the task finish was
+	// observed.
+	Elapsed time.Duration
+}
+
+type serversMigrationResponse struct {
+	Data ServersMigration `json:"data"`
+}
+
 // Create creates new server's migration.
 func (s *ServersMigrationsService) Create(ctx context.Context, data ServersMigrationRequest) (ServersMigration, error) {
-	var resp struct {
-		Data ServersMigration `json:"data"`
-	}
+	var resp serversMigrationResponse
 	return resp.Data, s.client.create(ctx, "servers_migrations", data, &resp)
 }
+
+// List lists server's migrations.
+func (s *ServersMigrationsService) List(ctx context.Context) (ServersMigrationsResponse, error) {
+	resp := ServersMigrationsResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, "servers_migrations", &resp)
+}
+
+// Get gets specified server's migration.
+func (s *ServersMigrationsService) Get(ctx context.Context, id int) (ServersMigration, error) {
+	var resp serversMigrationResponse
+	return resp.Data, s.client.get(ctx, fmt.Sprintf("servers_migrations/%d", id), &resp)
+}
+
+// Wait waits until every server migration task of specified server's migration
+// is finished. The optional onFinish callback is called as soon as a server
+// migration is finished.
+// Returns results in the same order as ServersMigration.Children. Failed server
+// migrations don't produce an error, they are reported by ServersMigrationResult.Err.
+func (s *ServersMigrationsService) Wait(
+	ctx context.Context,
+	id int,
+	onFinish func(ServersMigrationResult),
+) ([]ServersMigrationResult, error) {
+	startedAt := time.Now()
+
+	migration, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ServersMigrationResult, len(migration.Children))
+	finished := make([]bool, len(migration.Children))
+	for i, t := range migration.Children {
+		results[i] = ServersMigrationResult{
+			ServerID: t.ComputeResourceVMID,
+			Task:     t,
+		}
+	}
+
+	err = s.client.poll(ctx, func(ctx context.Context) (bool, error) {
+		done := true
+		for i := range results {
+			if finished[i] {
+				continue
+			}
+
+			task, err := s.client.Tasks.Get(ctx, results[i].Task.ID)
+			if err != nil {
+				return false, err
+			}
+			results[i].Task = task
+
+			if !task.IsFinished() {
+				done = false
+				continue
+			}
+
+			finished[i] = true
+			results[i].Elapsed = time.Since(startedAt)
+			if task.Status != TaskStatusDone {
+				results[i].Err = fmt.Errorf(
+					"migration of server %d is finished with %q status: %s",
+					results[i].ServerID,
+					task.Status,
+					task.Output,
+				)
+			}
+
+			if onFinish != nil {
+				onFinish(results[i])
+			}
+		}
+		return done, nil
+	})
+	return results, err
+}
@@ -0,0 +1,212 @@
+package solus
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// ServersMigrationPlan represents a plan for moving servers away from a compute
+// resource.
+type ServersMigrationPlan struct {
+	// Destinations maps a destination compute resource ID to IDs of the servers
+	// which should be migrated to it.
+	Destinations map[int][]int
+
+	// Unplaced IDs of the servers which don't fit into any destination.
+	Unplaced []int
+}
+
+// Requests converts the plan to requests for creating server's migrations.
+// One request is produced per destination compute resource.
+func (p ServersMigrationPlan) Requests(isLive, preserveIPs bool) []ServersMigrationRequest {
+	ids := make([]int, 0, len(p.Destinations))
+	for id := range p.Destinations {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	rr := make([]ServersMigrationRequest, 0, len(ids))
+	for _, id := range ids {
+		rr = append(rr, ServersMigrationRequest{
+			IsLive:                       isLive,
+			PreserveIPs:                  preserveIPs,
+			DestinationComputeResourceID: id,
+			Servers:                      p.Destinations[id],
+		})
+	}
+	return rr
+}
+
+// Plan plans migration of all servers from specified source compute resource
+// to the rest of compute resources. Servers which are processing right now,
+// e.g. are being migrated already, are skipped.
+// See PlanServersMigration for details about choosing destinations.
+func (s *ServersMigrationsService) Plan(ctx context.Context, sourceID int) (ServersMigrationPlan, error) {
+	servers, err := s.client.VirtualServers.List(ctx, (&FilterVirtualServers{}).ByComputeResourceID(sourceID))
+	if err != nil {
+		return ServersMigrationPlan{}, err
+	}
+
+	var ss []VirtualServer
+	for {
+		for _, vs := range servers.Data {
+			if !vs.IsProcessing {
+				ss = append(ss, vs)
+			}
+		}
+		if !servers.Next(ctx) {
+			break
+		}
+	}
+	if servers.Err() != nil {
+		return ServersMigrationPlan{}, servers.Err()
+	}
+
+	computeResources, err := s.client.ComputeResources.List(ctx, &FilterComputeResources{})
+	if err != nil {
+		return ServersMigrationPlan{}, err
+	}
+
+	var destinations []ComputeResource
+	for {
+		for _, cr := range computeResources.Data {
+			if cr.ID != sourceID {
+				destinations = append(destinations, cr)
+			}
+		}
+		if !computeResources.Next(ctx) {
+			break
+		}
+	}
+	if computeResources.Err() != nil {
+		return ServersMigrationPlan{}, computeResources.Err()
+	}
+
+	return PlanServersMigration(ss, destinations), nil
+}
+
+// PlanServersMigration distributes servers across destination compute
+// resources.
+// Only active and not locked destinations which support server's virtualization
+// type are used. A destination is chosen only if it has enough free VM, disk,
+// RAM and VCPU capacity according to its ComputeResourceSettingsLimits.
+// Servers are placed from the biggest to the smallest one, each to the
+// destination with the most free RAM.
+func PlanServersMigration(servers []VirtualServer, destinations []ComputeResource) ServersMigrationPlan {
+	capacities := make([]computeResourceCapacity, 0, len(destinations))
+	for _, cr := range destinations {
+		if cr.Status != ComputeResourceStatusActive || cr.IsLocked {
+			continue
+		}
+		capacities = append(capacities, newComputeResourceCapacity(cr))
+	}
+
+	ss := make([]VirtualServer, len(servers))
+	copy(ss, servers)
+	sort.SliceStable(ss, func(i, j int) bool {
+		return ss[i].Specifications.RAM > ss[j].Specifications.RAM
+	})
+
+	plan := ServersMigrationPlan{
+		Destinations: map[int][]int{},
+	}
+	for _, vs := range ss {
+		best := -1
+		for i := range capacities {
+			if !capacities[i].fits(vs) {
+				continue
+			}
+			if best == -1 || capacities[i].ram.free() > capacities[best].ram.free() {
+				best = i
+			}
+		}
+
+		if best == -1 {
+			plan.Unplaced = append(plan.Unplaced, vs.ID)
+			continue
+		}
+
+		capacities[best].place(vs)
+		id := capacities[best].computeResource.ID
+		plan.Destinations[id] = append(plan.Destinations[id], vs.ID)
+	}
+	return plan
+}
+
+// computeResourceCapacity tracks free capacity of a compute resource while
+// placing servers on it.
+type computeResourceCapacity struct {
+	computeResource ComputeResource
+	vm              capacityLimit
+	hdd             capacityLimit
+	ram             capacityLimit
+	vcpu            capacityLimit
+}
+
+func newComputeResourceCapacity(cr ComputeResource) computeResourceCapacity {
+	l := cr.Settings.Limits
+	return computeResourceCapacity{
+		computeResource: cr,
+		vm:              newCapacityLimit(l.VM),
+		hdd:             newCapacityLimit(l.HDD),
+		ram:             newCapacityLimit(l.RAM),
+		vcpu:            newCapacityLimit(l.VCPU),
+	}
+}
+
+func (c computeResourceCapacity) fits(vs VirtualServer) bool {
+	vt := c.computeResource.Settings.VirtualizationTypes
+	if len(vt) > 0 && vs.VirtualizationType != "" {
+		supported := false
+		for _, t := range vt {
+			if t == vs.VirtualizationType {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return false
+		}
+	}
+
+	spec := vs.Specifications
+	return c.vm.fits(1) &&
+		c.hdd.fits(float64(spec.Disk)) &&
+		c.ram.fits(float64(spec.RAM)) &&
+		c.vcpu.fits(float64(spec.VCPU))
+}
+
+func (c *computeResourceCapacity) place(vs VirtualServer) {
+	spec := vs.Specifications
+	c.vm.used++
+	c.hdd.used += float64(spec.Disk)
+	c.ram.used += float64(spec.RAM)
+	c.vcpu.used += float64(spec.VCPU)
+}
+
+// capacityLimit represents single mutable compute resource limit.
+type capacityLimit struct {
+	unlimited bool
+	total     float64
+	used      float64
+}
+
+func newCapacityLimit(l ComputeResourceSettingsLimit) capacityLimit {
+	return capacityLimit{
+		unlimited: l.Unlimited,
+		total:     float64(l.Total),
+		used:      float64(l.Used),
+	}
+}
+
+func (l capacityLimit) free() float64 {
+	if l.unlimited {
+		return math.MaxFloat64
+	}
+	return l.total - l.used
+}
+
+func (l capacityLimit) fits(v float64) bool {
+	return l.unlimited || l.free() >= v
+}
@@ -0,0 +1,183 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeCapacityComputeResource(id int, vms, hdd, ram, vcpu float32) ComputeResource {
+	return ComputeResource{
+		ID:     id,
+		Status: ComputeResourceStatusActive,
+		Settings: ComputeResourceSettings{
+			Limits: ComputeResourceSettingsLimits{
+				VM:   ComputeResourceSettingsLimit{Total: vms},
+				HDD:  ComputeResourceSettingsLimit{Total: hdd},
+				RAM:  ComputeResourceSettingsLimit{Total: ram},
+				VCPU: ComputeResourceSettingsLimit{Total: vcpu},
+			},
+		},
+	}
+}
+
+func fakeSizedServer(id, disk, ram, vcpu int) VirtualServer {
+	return VirtualServer{
+		ID:                 id,
+		VirtualizationType: VirtualizationTypeKVM,
+		Specifications: VirtualServerSpecifications{
+			Disk: disk,
+			RAM:  ram,
+			VCPU: vcpu,
+		},
+	}
+}
+
+func TestPlanServersMigration(t *testing.T) {
+	locked := fakeCapacityComputeResource(4, 100, 100, 100, 100)
+	locked.IsLocked = true
+
+	inactive := fakeCapacityComputeResource(5, 100, 100, 100, 100)
+	inactive.Status = ComputeResourceStatusUnavailable
+
+	vz := fakeCapacityComputeResource(6, 100, 100, 100, 100)
+	vz.Settings.VirtualizationTypes = []VirtualizationType{VirtualizationTypeVZ}
+
+	unlimited := fakeCapacityComputeResource(7, 0, 0, 0, 0)
+	unlimited.Settings.Limits.VM.Unlimited = true
+	unlimited.Settings.Limits.HDD.Unlimited = true
+	unlimited.Settings.Limits.RAM.Unlimited = true
+	unlimited.Settings.Limits.VCPU.Unlimited = true
+
+	cc := map[string]struct {
+		servers      []VirtualServer
+		destinations []ComputeResource
+		expected     ServersMigrationPlan
+	}{
+		"balanced by free RAM": {
+			servers: []VirtualServer{
+				fakeSizedServer(1, 10, 2, 1),
+				fakeSizedServer(2, 10, 8, 1),
+				fakeSizedServer(3, 10, 4, 1),
+			},
+			destinations: []ComputeResource{
+				fakeCapacityComputeResource(1, 10, 100, 10, 10),
+				fakeCapacityComputeResource(2, 10, 100, 12, 10),
+			},
+			expected: ServersMigrationPlan{
+				Destinations: map[int][]int{
+					1: {3, 1},
+					2: {2},
+				},
+			},
+		},
+
+		"limited by VMs count": {
+			servers: []VirtualServer{
+				fakeSizedServer(1, 1, 1, 1),
+				fakeSizedServer(2, 1, 1, 1),
+			},
+			destinations: []ComputeResource{
+				fakeCapacityComputeResource(1, 1, 100, 100, 100),
+			},
+			expected: ServersMigrationPlan{
+				Destinations: map[int][]int{
+					1: {1},
+				},
+				Unplaced: []int{2},
+			},
+		},
+
+		"limited by disk and VCPU": {
+			servers: []VirtualServer{
+				fakeSizedServer(1, 50, 1, 1),
+				fakeSizedServer(2, 1, 1, 8),
+			},
+			destinations: []ComputeResource{
+				fakeCapacityComputeResource(1, 10, 20, 100, 4),
+			},
+			expected: ServersMigrationPlan{
+				Destinations: map[int][]int{},
+				Unplaced:     []int{1, 2},
+			},
+		},
+
+		"skip unsuitable destinations": {
+			servers: []VirtualServer{
+				fakeSizedServer(1, 1, 1, 1),
+			},
+			destinations: []ComputeResource{locked, inactive, vz, unlimited},
+			expected: ServersMigrationPlan{
+				Destinations: map[int][]int{
+					7: {1},
+				},
+			},
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, PlanServersMigration(c.servers, c.destinations))
+		})
+	}
+}
+
+func TestServersMigrationPlan_Requests(t *testing.T) {
+	p := ServersMigrationPlan{
+		Destinations: map[int][]int{
+			2: {3},
+			1: {1, 2},
+		},
+		Unplaced: []int{4},
+	}
+
+	assert.Equal(t, []ServersMigrationRequest{
+		{
+			IsLive:                       true,
+			DestinationComputeResourceID: 1,
+			Servers:                      []int{1, 2},
+		},
+		{
+			IsLive:                       true,
+			DestinationComputeResourceID: 2,
+			Servers:                      []int{3},
+		},
+	}, p.Requests(true, false))
+}
+
+func TestServersMigrationsService_Plan(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		switch r.URL.Path {
+		case "/servers":
+			assert.Equal(t, "1", r.URL.Query().Get("filter[compute_resource_id]"))
+			writeJSON(t, w, http.StatusOK, VirtualServersResponse{
+				Data: []VirtualServer{fakeSizedServer(10, 1, 1, 1)},
+			})
+
+		case "/compute_resources":
+			writeJSON(t, w, http.StatusOK, ComputeResourcesResponse{
+				Data: []ComputeResource{
+					fakeCapacityComputeResource(1, 100, 100, 100, 100),
+					fakeCapacityComputeResource(2, 10, 10, 10, 10),
+				},
+			})
+
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ServersMigrations.Plan(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, ServersMigrationPlan{
+		Destinations: map[int][]int{
+			2: {10},
+		},
+	}, actual)
+}
@@ -30,3 +30,112 @@ func TestServersMigrationsService_Create(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, fakeServersMigration, actual)
 }
+
+func TestServersMigrationsService_List(t *testing.T) {
+	expected := ServersMigrationsResponse{
+		Data: []ServersMigration{
+			fakeServersMigration,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers_migrations", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ServersMigrations.List(context.Background())
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
+func TestServersMigrationsService_Get(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers_migrations/10", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeServersMigration)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).ServersMigrations.Get(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeServersMigration, actual)
+}
+
+func TestServersMigrationsService_Wait(t *testing.T) {
+	migration := ServersMigration{
+		ID: 10,
+		Children: []Task{
+			{ID: 11, ComputeResourceVMID: 1, Status: TaskStatusQueued},
+			{ID: 12, ComputeResourceVMID: 2, Status: TaskStatusQueued},
+		},
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		calls := map[string]int{}
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			calls[r.URL.Path]++
+
+			switch r.URL.Path {
+			case "/servers_migrations/10":
+				writeResponse(t, w, http.StatusOK, migration)
+
+			case "/tasks/11":
+				writeResponse(t, w, http.StatusOK, Task{ID: 11, ComputeResourceVMID: 1, Status: TaskStatusDone})
+
+			case "/tasks/12":
+				task := Task{ID: 12, ComputeResourceVMID: 2, Status: TaskStatusRunning}
+				if calls[r.URL.Path] > 2 {
+					task.Status = TaskStatusFailed
+					task.Output = "fake output"
+				}
+				writeResponse(t, w, http.StatusOK, task)
+
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+		})
+		defer s.Close()
+
+		var finished []int
+		actual, err := createTestClient(t, s.URL).ServersMigrations.Wait(
+			context.Background(),
+			10,
+			func(r ServersMigrationResult) { finished = append(finished, r.ServerID) },
+		)
+		require.NoError(t, err)
+		require.Len(t, actual, 2)
+
+		assert.Equal(t, 1, actual[0].ServerID)
+		assert.Equal(t, TaskStatusDone, actual[0].Task.Status)
+		assert.NoError(t, actual[0].Err)
+
+		assert.Equal(t, 2, actual[1].ServerID)
+		assert.Equal(t, TaskStatusFailed, actual[1].Task.Status)
+		assert.EqualError(t, actual[1].Err, `migration of server 2 is finished with "failed" status: fake output`)
+		assert.GreaterOrEqual(t, actual[1].Elapsed, actual[0].Elapsed)
+
+		assert.Equal(t, []int{1, 2}, finished)
+		assert.Equal(t, 1, calls["/tasks/11"])
+		assert.Equal(t, 3, calls["/tasks/12"])
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/servers_migrations/10" {
+				writeResponse(t, w, http.StatusOK, migration)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		defer s.Close()
+
+		_, err := createTestClient(t, s.URL).ServersMigrations.Wait(context.Background(), 10, nil)
+		require.EqualError(t, err, "HTTP GET tasks/11 returns 400 status code")
+	})
+}
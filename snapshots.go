@@ -38,6 +38,14 @@ type SnapshotRequest struct {
 	Name string `json:"name"`
 }
 
+// SnapshotsResponse represents paginated list of snapshots.
+// This cursor can be used for iterating over all available snapshots.
+type SnapshotsResponse struct {
+	paginatedResponse
+
+	Data []Snapshot `json:"data"`
+}
+
 type snapshotResponse struct {
 	Data Snapshot `json:"data"`
 }
@@ -48,6 +56,29 @@ func (s *SnapshotsService) Get(ctx context.Context, id int) (Snapshot, error) {
 	return resp.Data, s.client.get(ctx, fmt.Sprintf("snapshots/%d", id), &resp)
 }
 
+// Wait waits until specified snapshot is created. Returns an error if the
+// snapshot creation is failed.
+func (s *SnapshotsService) Wait(ctx context.Context, id int) (Snapshot, error) {
+	var snapshot Snapshot
+	err := s.client.poll(ctx, func(ctx context.Context) (bool, error) {
+		var err error
+		snapshot, err = s.Get(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		switch snapshot.Status {
+		case SnapshotStatusAvailable:
+			return true, nil
+		case SnapshotStatusFailed:
+			return false, fmt.Errorf("snapshot %d is failed", id)
+		default:
+			return false, nil
+		}
+	})
+	return snapshot, err
+}
+
 // Revert reverts VM from specified snapshot.
 func (s *SnapshotsService) Revert(ctx context.Context, id int) (Task, error) {
 	return s.client.asyncPost(ctx, fmt.Sprintf("snapshots/%d/revert", id))
@@ -3,6 +3,7 @@ package solus
 import (
 	"context"
 	"net/http"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,52 @@ func TestSnapshotsService_Get(t *testing.T) {
 	require.Equal(t, fakeSnapshot, actual)
 }
 
+func TestSnapshotsService_Wait(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		calls := int32(0)
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/snapshots/10", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			snapshot := fakeSnapshot
+			if atomic.AddInt32(&calls, 1) < 3 {
+				snapshot.Status = SnapshotStatusProcessing
+			}
+			writeResponse(t, w, http.StatusOK, snapshot)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Snapshots.Wait(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, fakeSnapshot, actual)
+		require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed snapshot", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				snapshot := fakeSnapshot
+				snapshot.Status = SnapshotStatusFailed
+				writeResponse(t, w, http.StatusOK, snapshot)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Snapshots.Wait(context.Background(), 10)
+			require.EqualError(t, err, "snapshot 10 is failed")
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Snapshots.Wait(context.Background(), 10)
+			require.EqualError(t, err, "HTTP GET snapshots/10 returns 400 status code")
+		})
+	})
+}
+
 func TestSnapshotsService_Revert(t *testing.T) {
 	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/snapshots/10/revert", r.URL.Path)
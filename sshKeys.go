@@ -22,6 +22,11 @@ type SSHKeyCreateRequest struct {
 	UserID int    `json:"user_id"`
 }
 
+// SSHKeyUpdateRequest represents available properties for updating a SSH key.
+type SSHKeyUpdateRequest struct {
+	Name string `json:"name"`
+}
+
 // SSHKeysResponse represents paginated list of SSH keys.
 // This cursor can be used for iterating over all available SSH keys.
 type SSHKeysResponse struct {
@@ -50,12 +55,22 @@ func (s *SSHKeysService) Get(ctx context.Context, id int) (SSHKey, error) {
 	return resp.Data, s.client.get(ctx, fmt.Sprintf("ssh_keys/%d", id), &resp)
 }
 
-// Create creates new SSH key.
+// Create creates new SSH key. The key is validated before sending the request.
 func (s *SSHKeysService) Create(ctx context.Context, data SSHKeyCreateRequest) (SSHKey, error) {
+	if err := data.Validate(); err != nil {
+		return SSHKey{}, err
+	}
+
 	var resp sshKeyResponse
 	return resp.Data, s.client.create(ctx, "ssh_keys", data, &resp)
 }
 
+// Update updates specified SSH key.
+func (s *SSHKeysService) Update(ctx context.Context, id int, data SSHKeyUpdateRequest) (SSHKey, error) {
+	var resp sshKeyResponse
+	return resp.Data, s.client.update(ctx, fmt.Sprintf("ssh_keys/%d", id), data, &resp)
+}
+
 // Delete deletes specified SSH key.
 func (s *SSHKeysService) Delete(ctx context.Context, id int) error {
 	return s.client.syncDelete(ctx, fmt.Sprintf("ssh_keys/%d", id))
@@ -10,3 +10,9 @@ func (f *FilterSSHKeys) ByName(name string) *FilterSSHKeys {
 	f.add("filter[search]", name)
 	return f
 }
+
+// ByUserID filter SSH keys by specified user ID.
+func (f *FilterSSHKeys) ByUserID(id int) *FilterSSHKeys {
+	f.addInt("filter[user_id]", id)
+	return f
+}
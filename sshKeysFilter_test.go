@@ -9,9 +9,11 @@ import (
 func TestFilterSSHKeys(t *testing.T) {
 	f := FilterSSHKeys{}
 
-	f.ByName("name")
+	f.ByName("name").
+		ByUserID(1)
 
 	require.Equal(t, map[string]string{
-		"filter[search]": "name",
+		"filter[search]":  "name",
+		"filter[user_id]": "1",
 	}, f.data)
 }
@@ -0,0 +1,129 @@
+package solus
+
+import (
+	"context"
+	"crypto/dsa" //nolint:staticcheck // DSA keys should be recognized to be rejected.
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHKeyMinRSABits a minimal size of RSA keys accepted by SSHKeyCreateRequest.Validate.
+const SSHKeyMinRSABits = 2048
+
+// SSHKeyInfo represents information parsed from SSH key's body.
+type SSHKeyInfo struct {
+	// Type an algorithm of the key, e.g. ssh-ed25519 or ssh-rsa.
+	Type string
+
+	// Bits a size of the key in bits.
+	Bits int
+
+	// Fingerprint a SHA256 fingerprint of the key in the same format as
+	// ssh-keygen prints it, e.g. SHA256:PfgXkkNb/...
+	Fingerprint string
+
+	Comment string
+}
+
+// ParseSSHKey parses SSH public key in authorized_keys format.
+func ParseSSHKey(body string) (SSHKeyInfo, error) {
+	key, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(body)))
+	if err != nil {
+		return SSHKeyInfo{}, fmt.Errorf("parse SSH key: %w", err)
+	}
+
+	info := SSHKeyInfo{
+		Type:        key.Type(),
+		Fingerprint: ssh.FingerprintSHA256(key),
+		Comment:     comment,
+	}
+
+	switch key.Type() {
+	case ssh.KeyAlgoED25519, ssh.KeyAlgoSKED25519:
+		info.Bits = 256
+		return info, nil
+	}
+
+	ck, ok := key.(ssh.CryptoPublicKey)
+	if !ok {
+		return info, nil
+	}
+
+	switch k := ck.CryptoPublicKey().(type) {
+	case *rsa.PublicKey:
+		info.Bits = k.N.BitLen()
+	case *ecdsa.PublicKey:
+		info.Bits = k.Curve.Params().BitSize
+	case *dsa.PublicKey:
+		info.Bits = k.P.BitLen()
+	}
+	return info, nil
+}
+
+// Info parses the SSH key's body.
+func (k SSHKey) Info() (SSHKeyInfo, error) {
+	return ParseSSHKey(k.Body)
+}
+
+// Validate checks the SSH key is well-formed and isn't weak. DSA keys and RSA
+// keys shorter than SSHKeyMinRSABits are considered as weak.
+func (r SSHKeyCreateRequest) Validate() error {
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+
+	info, err := ParseSSHKey(r.Body)
+	if err != nil {
+		return err
+	}
+
+	switch info.Type {
+	case ssh.KeyAlgoDSA:
+		return fmt.Errorf("%s keys are weak and not allowed", info.Type)
+	case ssh.KeyAlgoRSA:
+		if info.Bits < SSHKeyMinRSABits {
+			return fmt.Errorf(
+				"%s key is too weak: %d bits, at least %d bits are required",
+				info.Type,
+				info.Bits,
+				SSHKeyMinRSABits,
+			)
+		}
+	}
+	return nil
+}
+
+// FindByFingerprint finds SSH key with specified SHA256 fingerprint among keys
+// matched the filter. It can be used to avoid creating duplicate keys. Keys
+// with malformed body are skipped.
+func (s *SSHKeysService) FindByFingerprint(
+	ctx context.Context,
+	filter *FilterSSHKeys,
+	fingerprint string,
+) (SSHKey, error) {
+	resp, err := s.List(ctx, filter)
+	if err != nil {
+		return SSHKey{}, err
+	}
+
+	for {
+		for _, k := range resp.Data {
+			info, err := k.Info()
+			if err == nil && info.Fingerprint == fingerprint {
+				return k, nil
+			}
+		}
+		if !resp.Next(ctx) {
+			break
+		}
+	}
+	if resp.Err() != nil {
+		return SSHKey{}, resp.Err()
+	}
+	return SSHKey{}, fmt.Errorf("SSH key with fingerprint %q not found", fingerprint)
+}
@@ -0,0 +1,148 @@
+package solus
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+const weakRSASSHKeyBody = "ssh-rsa " +
+	"AAAAB3NzaC1yc2EAAAADAQABAAAAgQDA14NQx4rE7G8xMNvQpMm8cGGbyzxLYchNkJwzKe+SGt0UexcfUWezROmDWoTfYv/+" +
+	"DW8fysu6Dc0VUW8ZpKzjwaTahOuLmRpDR5kZHL358zI4hI4F0RucnP7IYdZhjz1gadGgKeUoK8WpGNgQefOL/v52L3sG9fr0zNylPwHtKQ=="
+
+func marshalTestSSHKey(t *testing.T, key interface{}) string {
+	t.Helper()
+
+	pub, err := ssh.NewPublicKey(key)
+	require.NoError(t, err)
+	return string(ssh.MarshalAuthorizedKey(pub))
+}
+
+func TestParseSSHKey(t *testing.T) {
+	t.Run("ed25519", func(t *testing.T) {
+		actual, err := ParseSSHKey(fakeSSHKeyBody)
+		require.NoError(t, err)
+		require.Equal(t, SSHKeyInfo{
+			Type:        "ssh-ed25519",
+			Bits:        256,
+			Fingerprint: fakeSSHKeyFingerprint,
+			Comment:     "fake@example.com",
+		}, actual)
+	})
+
+	t.Run("rsa", func(t *testing.T) {
+		actual, err := ParseSSHKey(weakRSASSHKeyBody)
+		require.NoError(t, err)
+		require.Equal(t, SSHKeyInfo{
+			Type:        "ssh-rsa",
+			Bits:        1024,
+			Fingerprint: "SHA256:sDwMsr84h7Mb/b6MbvZdkJ1eiyc5EaHT4jXtlgGwxJg",
+		}, actual)
+	})
+
+	t.Run("ecdsa", func(t *testing.T) {
+		key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+
+		actual, err := ParseSSHKey(marshalTestSSHKey(t, &key.PublicKey))
+		require.NoError(t, err)
+		require.Equal(t, "ecdsa-sha2-nistp384", actual.Type)
+		require.Equal(t, 384, actual.Bits)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := ParseSSHKey("ssh-rsa foo")
+		require.EqualError(t, err, "parse SSH key: ssh: no key found")
+	})
+}
+
+func TestSSHKey_Info(t *testing.T) {
+	actual, err := fakeSSHKey.Info()
+	require.NoError(t, err)
+	require.Equal(t, fakeSSHKeyFingerprint, actual.Fingerprint)
+}
+
+func TestSSHKeyCreateRequest_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		key, err := rsa.GenerateKey(rand.Reader, SSHKeyMinRSABits)
+		require.NoError(t, err)
+
+		for name, body := range map[string]string{
+			"ed25519": fakeSSHKeyBody,
+			"rsa":     marshalTestSSHKey(t, &key.PublicKey),
+		} {
+			t.Run(name, func(t *testing.T) {
+				require.NoError(t, SSHKeyCreateRequest{Name: "name", Body: body}.Validate())
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		testCases := map[string]struct {
+			given    SSHKeyCreateRequest
+			expected string
+		}{
+			"empty name": {
+				given:    SSHKeyCreateRequest{Body: fakeSSHKeyBody},
+				expected: "name is required",
+			},
+			"malformed body": {
+				given:    SSHKeyCreateRequest{Name: "name", Body: "body"},
+				expected: "parse SSH key: ssh: no key found",
+			},
+			"weak RSA key": {
+				given:    SSHKeyCreateRequest{Name: "name", Body: weakRSASSHKeyBody},
+				expected: "ssh-rsa key is too weak: 1024 bits, at least 2048 bits are required",
+			},
+		}
+
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				require.EqualError(t, tc.given.Validate(), tc.expected)
+			})
+		}
+	})
+}
+
+func TestSSHKeysService_FindByFingerprint(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ssh_keys", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, SSHKeysResponse{
+			Data: []SSHKey{
+				{ID: 2, Name: "malformed", Body: "body"},
+				{ID: 3, Name: "weak", Body: weakRSASSHKeyBody},
+				fakeSSHKey,
+			},
+		})
+	})
+	defer s.Close()
+
+	t.Run("positive", func(t *testing.T) {
+		actual, err := createTestClient(t, s.URL).SSHKeys.FindByFingerprint(
+			context.Background(),
+			&FilterSSHKeys{},
+			fakeSSHKeyFingerprint,
+		)
+		require.NoError(t, err)
+		require.Equal(t, fakeSSHKey, actual)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := createTestClient(t, s.URL).SSHKeys.FindByFingerprint(
+			context.Background(),
+			&FilterSSHKeys{},
+			"SHA256:unknown",
+		)
+		require.EqualError(t, err, `SSH key with fingerprint "SHA256:unknown" not found`)
+	})
+}
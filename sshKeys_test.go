@@ -51,22 +51,54 @@ func TestSSHKeysService_Get(t *testing.T) {
 }
 
 func TestSSHKeysService_Create(t *testing.T) {
-	data := SSHKeyCreateRequest{
-		Name:   "name",
-		Body:   "body",
-		UserID: 1,
+	t.Run("positive", func(t *testing.T) {
+		data := SSHKeyCreateRequest{
+			Name:   "name",
+			Body:   fakeSSHKeyBody,
+			UserID: 1,
+		}
+
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/ssh_keys", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assertRequestBody(t, r, data)
+
+			writeResponse(t, w, http.StatusCreated, fakeSSHKey)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).SSHKeys.Create(context.Background(), data)
+		require.NoError(t, err)
+		require.Equal(t, fakeSSHKey, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		data := SSHKeyCreateRequest{
+			Name:   "name",
+			Body:   "body",
+			UserID: 1,
+		}
+
+		_, err := createTestClient(t, "").SSHKeys.Create(context.Background(), data)
+		require.EqualError(t, err, "parse SSH key: ssh: no key found")
+	})
+}
+
+func TestSSHKeysService_Update(t *testing.T) {
+	data := SSHKeyUpdateRequest{
+		Name: "name",
 	}
 
 	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
-		assert.Equal(t, "/ssh_keys", r.URL.Path)
-		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/ssh_keys/10", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
 		assertRequestBody(t, r, data)
 
-		writeResponse(t, w, http.StatusCreated, fakeSSHKey)
+		writeResponse(t, w, http.StatusOK, fakeSSHKey)
 	})
 	defer s.Close()
 
-	actual, err := createTestClient(t, s.URL).SSHKeys.Create(context.Background(), data)
+	actual, err := createTestClient(t, s.URL).SSHKeys.Update(context.Background(), 10, data)
 	require.NoError(t, err)
 	require.Equal(t, fakeSSHKey, actual)
 }
@@ -3,36 +3,177 @@ package solus
 import (
 	"context"
 	"fmt"
+	"net/http"
 )
 
-// SnapshotsService handles all available methods with snapshots.
+// StorageService handles all available methods with storages.
 type StorageService service
 
 // Storage represents a storage.
 type Storage struct {
-	ID                      int                    `json:"id"`
-	Name                    string                 `json:"name"`
-	Type                    StorageType            `json:"type"`
-	Path                    string                 `json:"path"`
-	Mount                   string                 `json:"mount"`
-	ThinPool                string                 `json:"thin_pool"`
-	IsAvailableForBalancing bool                   `json:"is_available_for_balancing"`
-	ServersCount            int                    `json:"servers_count"`
-	ComputeResourcesCount   int                    `json:"compute_resources_count"`
-	FreeSpace               float64                `json:"free_space"`
-	Credentials             map[string]interface{} `json:"credentials"`
+	ID                      int                `json:"id"`
+	Name                    string             `json:"name"`
+	Type                    StorageType        `json:"type"`
+	Path                    string             `json:"path"`
+	Mount                   string             `json:"mount"`
+	ThinPool                string             `json:"thin_pool"`
+	IsAvailableForBalancing bool               `json:"is_available_for_balancing"`
+	ServersCount            int                `json:"servers_count"`
+	ComputeResourcesCount   int                `json:"compute_resources_count"`
+	FreeSpace               float64            `json:"free_space"`
+	Credentials             StorageCredentials `json:"credentials"`
+}
+
+// StorageCreateRequest represents available properties for creating a new
+// shared storage.
+type StorageCreateRequest struct {
+	Name                    string             `json:"name"`
+	Type                    StorageTypeName    `json:"type"`
+	Path                    string             `json:"path"`
+	Mount                   string             `json:"mount,omitempty"`
+	IsAvailableForBalancing bool               `json:"is_available_for_balancing"`
+	ComputeResources        []int              `json:"compute_resources"`
+	Credentials             StorageCredentials `json:"credentials,omitempty"`
+}
+
+// StorageUpdateRequest represents available properties for updating a storage.
+type StorageUpdateRequest struct {
+	Name                    string             `json:"name"`
+	Path                    string             `json:"path"`
+	Mount                   string             `json:"mount,omitempty"`
+	ThinPool                string             `json:"thin_pool,omitempty"`
+	IsAvailableForBalancing bool               `json:"is_available_for_balancing"`
+	Credentials             StorageCredentials `json:"credentials,omitempty"`
+}
+
+type storageComputeResourcesRequest struct {
+	ComputeResources []int `json:"compute_resources"`
+}
+
+// StoragesResponse represents paginated list of storages.
+// This cursor can be used for iterating over all available storages.
+type StoragesResponse struct {
+	paginatedResponse
+
+	Data []Storage `json:"data"`
 }
 
 type storageResponse struct {
 	Data Storage `json:"data"`
 }
 
+// List lists storages.
+func (s *StorageService) List(ctx context.Context, filter *FilterStorages) (StoragesResponse, error) {
+	resp := StoragesResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, "storages", &resp, withFilter(filter.data))
+}
+
+// Create creates a new shared storage which is available on all specified
+// compute resources.
+func (s *StorageService) Create(ctx context.Context, data StorageCreateRequest) (Storage, error) {
+	if err := data.Validate(); err != nil {
+		return Storage{}, err
+	}
+
+	var resp storageResponse
+	return resp.Data, s.client.create(ctx, "storages", data, &resp)
+}
+
+// CreateNFS creates a new shared NFS storage which is available on all
+// specified compute resources.
+func (s *StorageService) CreateNFS(
+	ctx context.Context,
+	name string,
+	credentials StorageNFSCredentials,
+	computeResources ...int,
+) (Storage, error) {
+	return s.Create(ctx, StorageCreateRequest{
+		Name:                    name,
+		Type:                    StorageTypeNameNFS,
+		Path:                    credentials.Folder,
+		IsAvailableForBalancing: true,
+		ComputeResources:        computeResources,
+		Credentials:             credentials,
+	})
+}
+
 // Get gets specified storage.
 func (s *StorageService) Get(ctx context.Context, id int) (Storage, error) {
 	var resp storageResponse
 	return resp.Data, s.client.get(ctx, fmt.Sprintf("storages/%d", id), &resp)
 }
 
+// Update updates specified storage. All the storage's properties are replaced,
+// use SetAvailableForBalancing to change only the balancing availability.
+func (s *StorageService) Update(ctx context.Context, id int, data StorageUpdateRequest) (Storage, error) {
+	if data.Credentials != nil {
+		if err := data.Credentials.Validate(); err != nil {
+			return Storage{}, fmt.Errorf("invalid credentials: %w", err)
+		}
+	}
+
+	var resp storageResponse
+	return resp.Data, s.client.update(ctx, fmt.Sprintf("storages/%d", id), data, &resp)
+}
+
+// SetAvailableForBalancing changes whether specified storage is available for
+// balancing. Since Update replaces all the storage's properties, the storage
+// is fetched first and its other properties are kept.
+func (s *StorageService) SetAvailableForBalancing(ctx context.Context, id int, available bool) (Storage, error) {
+	storage, err := s.Get(ctx, id)
+	if err != nil {
+		return Storage{}, err
+	}
+
+	return s.Update(ctx, id, StorageUpdateRequest{
+		Name:                    storage.Name,
+		Path:                    storage.Path,
+		Mount:                   storage.Mount,
+		ThinPool:                storage.ThinPool,
+		IsAvailableForBalancing: available,
+		Credentials:             storage.Credentials,
+	})
+}
+
+// Attach makes specified storage available on additional compute resources.
+func (s *StorageService) Attach(ctx context.Context, id int, computeResources ...int) (Storage, error) {
+	return s.computeResourcesAction(ctx, id, "attach", computeResources)
+}
+
+// Detach makes specified storage unavailable on the compute resources.
+func (s *StorageService) Detach(ctx context.Context, id int, computeResources ...int) (Storage, error) {
+	return s.computeResourcesAction(ctx, id, "detach", computeResources)
+}
+
+func (s *StorageService) computeResourcesAction(
+	ctx context.Context,
+	id int,
+	action string,
+	computeResources []int,
+) (Storage, error) {
+	path := fmt.Sprintf("storages/%d/%s", id, action)
+	body, code, err := s.client.request(
+		ctx,
+		http.MethodPost,
+		path,
+		withBody(storageComputeResourcesRequest{ComputeResources: computeResources}),
+	)
+	if err != nil {
+		return Storage{}, err
+	}
+
+	if code != http.StatusOK {
+		return Storage{}, newHTTPError(http.MethodPost, path, code, body)
+	}
+
+	var resp storageResponse
+	return resp.Data, unmarshal(body, &resp)
+}
+
 // Delete deletes specified storage.
 func (s *StorageService) Delete(ctx context.Context, id int) error {
 	return s.client.syncDelete(ctx, fmt.Sprintf("storages/%d", id))
@@ -0,0 +1,119 @@
+package solus
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// StorageCredentials represents credentials for accessing a storage.
+// Concrete type of the credentials depends on the storage type. Only shared
+// storages have credentials.
+type StorageCredentials interface {
+	// Validate checks all required credentials are specified.
+	Validate() error
+
+	storageType() StorageTypeName
+}
+
+// StorageNFSCredentials represents credentials for mounting NFS storage.
+type StorageNFSCredentials struct {
+	// Server a hostname or an IP address of NFS server.
+	Server string `json:"server"`
+	// Folder an exported folder on NFS server.
+	Folder string `json:"folder"`
+	// Options mount options.
+	Options string `json:"options,omitempty"`
+}
+
+var _ StorageCredentials = StorageNFSCredentials{}
+
+// Validate checks all required credentials are specified.
+func (c StorageNFSCredentials) Validate() error {
+	return requireCredentials(
+		requiredCredential{"server", c.Server},
+		requiredCredential{"folder", c.Folder},
+	)
+}
+
+func (StorageNFSCredentials) storageType() StorageTypeName {
+	return StorageTypeNameNFS
+}
+
+// StorageRawCredentials represents credentials of a storage type which is
+// unknown to the client.
+type StorageRawCredentials map[string]interface{}
+
+var _ StorageCredentials = StorageRawCredentials{}
+
+// Validate always succeeds since the client doesn't know which credentials
+// are required.
+func (StorageRawCredentials) Validate() error { return nil }
+
+func (StorageRawCredentials) storageType() StorageTypeName { return "" }
+
+// UnmarshalJSON decodes the storage with credentials specific to its type.
+func (s *Storage) UnmarshalJSON(b []byte) error {
+	type alias Storage
+	var v struct {
+		alias
+
+		Credentials json.RawMessage `json:"credentials"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	c, err := decodeStorageCredentials(v.Type.Name, v.Credentials)
+	if err != nil {
+		return err
+	}
+
+	*s = Storage(v.alias)
+	s.Credentials = c
+	return nil
+}
+
+func decodeStorageCredentials(t StorageTypeName, raw json.RawMessage) (StorageCredentials, error) {
+	if isEmptyCredentials(raw) {
+		return nil, nil
+	}
+
+	var (
+		c   StorageCredentials
+		err error
+	)
+	switch t {
+	case StorageTypeNameNFS:
+		var v StorageNFSCredentials
+		err = json.Unmarshal(raw, &v)
+		c = v
+
+	default:
+		var v StorageRawCredentials
+		err = json.Unmarshal(raw, &v)
+		c = v
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode %q storage credentials: %w", t, err)
+	}
+	return c, nil
+}
+
+// Validate checks the credentials are valid and match the storage type.
+func (r StorageCreateRequest) Validate() error {
+	if r.Credentials == nil {
+		if r.Type == StorageTypeNameNFS {
+			return fmt.Errorf("%q storage requires credentials", r.Type)
+		}
+		return nil
+	}
+
+	if t := r.Credentials.storageType(); t != "" && t != r.Type {
+		return fmt.Errorf("%q credentials can't be used for %q storage", t, r.Type)
+	}
+
+	if err := r.Credentials.Validate(); err != nil {
+		return fmt.Errorf("invalid credentials: %w", err)
+	}
+	return nil
+}
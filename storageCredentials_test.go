@@ -0,0 +1,131 @@
+package solus
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageNFSCredentials_Validate(t *testing.T) {
+	c := StorageNFSCredentials{
+		Server: "192.0.2.1",
+		Folder: "/export",
+	}
+	require.NoError(t, c.Validate())
+
+	c.Folder = ""
+	require.EqualError(t, c.Validate(), "folder is required")
+}
+
+func TestStorageCreateRequest_Validate(t *testing.T) {
+	cc := map[string]struct {
+		request  StorageCreateRequest
+		expected string
+	}{
+		"local storage": {
+			request: StorageCreateRequest{Type: StorageTypeNameFB},
+		},
+		"nfs without credentials": {
+			request:  StorageCreateRequest{Type: StorageTypeNameNFS},
+			expected: `"nfs" storage requires credentials`,
+		},
+		"type mismatch": {
+			request: StorageCreateRequest{
+				Type:        StorageTypeNameLVM,
+				Credentials: StorageNFSCredentials{},
+			},
+			expected: `"nfs" credentials can't be used for "lvm" storage`,
+		},
+		"invalid credentials": {
+			request: StorageCreateRequest{
+				Type:        StorageTypeNameNFS,
+				Credentials: StorageNFSCredentials{Folder: "/export"},
+			},
+			expected: "invalid credentials: server is required",
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			err := c.request.Validate()
+			if c.expected == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, c.expected)
+		})
+	}
+}
+
+func TestStorageCreateRequest_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(StorageCreateRequest{
+		Name:             "nfs",
+		Type:             StorageTypeNameNFS,
+		Path:             "/export",
+		ComputeResources: []int{1},
+		Credentials: StorageNFSCredentials{
+			Server:  "192.0.2.1",
+			Folder:  "/export",
+			Options: "ro",
+		},
+	})
+	require.NoError(t, err)
+	require.JSONEq(
+		t,
+		`{"name":"nfs","type":"nfs","path":"/export","is_available_for_balancing":false,"compute_resources":[1],`+
+			`"credentials":{"server":"192.0.2.1","folder":"/export","options":"ro"}}`,
+		string(b),
+	)
+}
+
+func TestStorage_UnmarshalJSON(t *testing.T) {
+	cc := map[string]struct {
+		given    string
+		expected Storage
+	}{
+		"nfs": {
+			given: `{"id":1,"type":{"name":"nfs"},"credentials":{` +
+				`"server":"192.0.2.1","folder":"/export","options":"ro"}}`,
+			expected: Storage{
+				ID:   1,
+				Type: StorageType{Name: StorageTypeNameNFS},
+				Credentials: StorageNFSCredentials{
+					Server:  "192.0.2.1",
+					Folder:  "/export",
+					Options: "ro",
+				},
+			},
+		},
+		"unknown type": {
+			given: `{"id":1,"type":{"name":"foo"},"credentials":{"foo":"bar"}}`,
+			expected: Storage{
+				ID:          1,
+				Type:        StorageType{Name: "foo"},
+				Credentials: StorageRawCredentials{"foo": "bar"},
+			},
+		},
+		"without credentials": {
+			given:    `{"id":1,"type":{"name":"fb"},"credentials":null}`,
+			expected: Storage{ID: 1, Type: StorageType{Name: StorageTypeNameFB}},
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			var actual Storage
+			require.NoError(t, json.Unmarshal([]byte(c.given), &actual))
+			require.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func TestStorageService_Update_Validate(t *testing.T) {
+	_, err := createTestClient(t, "http://example.com").Storage.Update(
+		context.Background(),
+		1,
+		StorageUpdateRequest{Credentials: StorageNFSCredentials{}},
+	)
+	require.EqualError(t, err, "invalid credentials: server is required")
+}
@@ -0,0 +1,18 @@
+package solus
+
+// FilterStorages represent available filters for fetching list of storages.
+type FilterStorages struct {
+	filter
+}
+
+// ByType filter storages by specified type.
+func (f *FilterStorages) ByType(t StorageTypeName) *FilterStorages {
+	f.add("filter[type]", string(t))
+	return f
+}
+
+// ByComputeResourceID filter storages by specified compute resource ID.
+func (f *FilterStorages) ByComputeResourceID(id int) *FilterStorages {
+	f.addInt("filter[compute_resource_id]", id)
+	return f
+}
@@ -0,0 +1,20 @@
+package solus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterStorages(t *testing.T) {
+	f := FilterStorages{}
+
+	f.
+		ByType(StorageTypeNameNFS).
+		ByComputeResourceID(1)
+
+	require.Equal(t, map[string]string{
+		"filter[type]":                string(StorageTypeNameNFS),
+		"filter[compute_resource_id]": "1",
+	}, f.data)
+}
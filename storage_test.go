@@ -3,6 +3,7 @@ package solus
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -35,3 +36,195 @@ func TestStorageService_Delete(t *testing.T) {
 	err := createTestClient(t, s.URL).Storage.Delete(context.Background(), 10)
 	require.NoError(t, err)
 }
+
+func TestStorageService_List(t *testing.T) {
+	expected := StoragesResponse{
+		Data: []Storage{
+			fakeStorage,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/storages", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assertRequestQuery(t, r, url.Values{
+			"filter[type]": []string{string(StorageTypeNameNFS)},
+		})
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	f := (&FilterStorages{}).ByType(StorageTypeNameNFS)
+
+	actual, err := createTestClient(t, s.URL).Storage.List(context.Background(), f)
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
+func TestStorageService_Create(t *testing.T) {
+	data := StorageCreateRequest{
+		Name:             "name",
+		Type:             StorageTypeNameFB,
+		Path:             "/var/lib",
+		ComputeResources: []int{1, 2},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/storages", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusCreated, fakeStorage)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Storage.Create(context.Background(), data)
+	require.NoError(t, err)
+	require.Equal(t, fakeStorage, actual)
+}
+
+func TestStorageService_CreateNFS(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/storages", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestJSON(t, r, StorageCreateRequest{
+			Name:                    "nfs",
+			Type:                    StorageTypeNameNFS,
+			Path:                    "/export",
+			IsAvailableForBalancing: true,
+			ComputeResources:        []int{1, 2},
+			Credentials: StorageNFSCredentials{
+				Server: "192.0.2.1",
+				Folder: "/export",
+			},
+		})
+
+		writeResponse(t, w, http.StatusCreated, fakeStorage)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Storage.CreateNFS(
+		context.Background(),
+		"nfs",
+		StorageNFSCredentials{Server: "192.0.2.1", Folder: "/export"},
+		1, 2,
+	)
+	require.NoError(t, err)
+	require.Equal(t, fakeStorage, actual)
+}
+
+func TestStorageService_Update(t *testing.T) {
+	data := StorageUpdateRequest{
+		Name:                    "name",
+		Path:                    "/var/lib",
+		IsAvailableForBalancing: true,
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/storages/10", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusOK, fakeStorage)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Storage.Update(context.Background(), 10, data)
+	require.NoError(t, err)
+	require.Equal(t, fakeStorage, actual)
+}
+
+func TestStorageService_SetAvailableForBalancing(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/storages/10", r.URL.Path)
+
+			switch r.Method {
+			case http.MethodGet:
+				writeResponse(t, w, http.StatusOK, fakeStorage)
+
+			case http.MethodPut:
+				assertRequestJSON(t, r, StorageUpdateRequest{
+					Name:                    fakeStorage.Name,
+					Path:                    fakeStorage.Path,
+					Mount:                   fakeStorage.Mount,
+					ThinPool:                fakeStorage.ThinPool,
+					IsAvailableForBalancing: false,
+					Credentials:             fakeStorage.Credentials,
+				})
+				writeResponse(t, w, http.StatusOK, fakeStorage)
+
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Storage.SetAvailableForBalancing(context.Background(), 10, false)
+		require.NoError(t, err)
+		require.Equal(t, fakeStorage, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		defer s.Close()
+
+		_, err := createTestClient(t, s.URL).Storage.SetAvailableForBalancing(context.Background(), 10, false)
+		require.EqualError(t, err, "HTTP GET storages/10 returns 400 status code")
+	})
+}
+
+func TestStorageService_Attach(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/storages/10/attach", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, map[string][]int{"compute_resources": {1, 2}})
+
+		writeResponse(t, w, http.StatusOK, fakeStorage)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Storage.Attach(context.Background(), 10, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, fakeStorage, actual)
+}
+
+func TestStorageService_Detach(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/storages/10/detach", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assertRequestBody(t, r, map[string][]int{"compute_resources": {3}})
+
+			writeResponse(t, w, http.StatusOK, fakeStorage)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Storage.Detach(context.Background(), 10, 3)
+		require.NoError(t, err)
+		require.Equal(t, fakeStorage, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to make request", func(t *testing.T) {
+			asserter, addr := startBrokenTestServer(t)
+			_, err := createTestClient(t, addr).Storage.Detach(context.Background(), 10, 3)
+			asserter(t, http.MethodPost, "/storages/10/detach", err)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Storage.Detach(context.Background(), 10, 3)
+			assert.EqualError(t, err, "HTTP POST storages/10/detach returns 400 status code")
+		})
+	})
+}
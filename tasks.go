@@ -154,14 +154,15 @@ const (
 
 // Task represents a task.
 type Task struct {
-	ID                int        `json:"id"`
-	ComputeResourceID int        `json:"compute_resource_id"`
-	Queue             string     `json:"queue"`
-	Action            TaskAction `json:"action"`
-	Status            TaskStatus `json:"status"`
-	Output            string     `json:"output"`
-	Progress          int        `json:"progress"`
-	Duration          int        `json:"duration"`
+	ID                  int        `json:"id"`
+	ComputeResourceID   int        `json:"compute_resource_id"`
+	ComputeResourceVMID int        `json:"compute_resource_vm_id"`
+	Queue               string     `json:"queue"`
+	Action              TaskAction `json:"action"`
+	Status              TaskStatus `json:"status"`
+	Output              string     `json:"output"`
+	Progress            int        `json:"progress"`
+	Duration            int        `json:"duration"`
 }
 
 // IsFinished returns true if the task is finished, successfully or not.
@@ -198,3 +199,26 @@ func (s *TasksService) Get(ctx context.Context, id int) (Task, error) {
 	var resp taskResponse
 	return resp.Data, s.client.get(ctx, fmt.Sprintf("tasks/%d", id), &resp)
 }
+
+// Wait waits until specified task is finished. Returns an error if the task
+// isn't finished successfully.
+func (s *TasksService) Wait(ctx context.Context, id int) (Task, error) {
+	var task Task
+	err := s.client.poll(ctx, func(ctx context.Context) (bool, error) {
+		var err error
+		task, err = s.Get(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		if !task.IsFinished() {
+			return false, nil
+		}
+
+		if task.Status != TaskStatusDone {
+			return false, fmt.Errorf("task %d is finished with %q status: %s", id, task.Status, task.Output)
+		}
+		return true, nil
+	})
+	return task, err
+}
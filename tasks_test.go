@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -76,3 +77,48 @@ func TestTasksService_Get(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, fakeTask, actual)
 }
+
+func TestTasksService_Wait(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		calls := int32(0)
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/tasks/10", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+
+			task := fakeTask
+			if atomic.AddInt32(&calls, 1) < 3 {
+				task.Status = TaskStatusRunning
+			}
+			writeResponse(t, w, http.StatusOK, task)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Tasks.Wait(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, fakeTask, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed task", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				task := fakeTask
+				task.Status = TaskStatusFailed
+				writeResponse(t, w, http.StatusOK, task)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Tasks.Wait(context.Background(), 10)
+			require.EqualError(t, err, `task 10 is finished with "failed" status: fake output`)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Tasks.Wait(context.Background(), 10)
+			require.EqualError(t, err, "HTTP GET tasks/10 returns 400 status code")
+		})
+	})
+}
@@ -33,6 +33,7 @@ type User struct {
 	Roles         []Role     `json:"roles"`
 	BillingUserID int        `json:"billing_user_id"`
 	BillingToken  string     `json:"billing_token"`
+	LimitGroup    LimitGroup `json:"limit_group"`
 }
 
 // UsersResponse represents paginated list of users.
@@ -57,10 +58,11 @@ type UserCreateRequest struct {
 
 // UserUpdateRequest represents available properties for updating exists user.
 type UserUpdateRequest struct {
-	Password   string `json:"password,omitempty"`
-	Status     string `json:"status,omitempty"`
-	LanguageID int    `json:"language_id,omitempty"`
-	Roles      []int  `json:"roles,omitempty"`
+	Password     string `json:"password,omitempty"`
+	Status       string `json:"status,omitempty"`
+	LanguageID   int    `json:"language_id,omitempty"`
+	Roles        []int  `json:"roles,omitempty"`
+	LimitGroupID int    `json:"limit_group_id,omitempty"`
 }
 
 type userResponse struct {
@@ -77,6 +79,12 @@ func (s *UsersService) List(ctx context.Context, filter *FilterUsers) (UsersResp
 	return resp, s.client.list(ctx, "users", &resp, withFilter(filter.data))
 }
 
+// Get gets specified user.
+func (s *UsersService) Get(ctx context.Context, id int) (User, error) {
+	var resp userResponse
+	return resp.Data, s.client.get(ctx, fmt.Sprintf("users/%d", id), &resp)
+}
+
 // Create creates new user.
 func (s *UsersService) Create(ctx context.Context, data UserCreateRequest) (User, error) {
 	var resp userResponse
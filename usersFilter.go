@@ -10,3 +10,21 @@ func (f *FilterUsers) ByStatus(status string) *FilterUsers {
 	f.add("filter[status]", status)
 	return f
 }
+
+// ByEmail filter users by specified email. Partial match is used.
+func (f *FilterUsers) ByEmail(email string) *FilterUsers {
+	f.add("filter[search]", email)
+	return f
+}
+
+// ByRoleID filter users by specified role ID.
+func (f *FilterUsers) ByRoleID(id int) *FilterUsers {
+	f.addInt("filter[role]", id)
+	return f
+}
+
+// ByBillingUserID filter users by specified billing user ID.
+func (f *FilterUsers) ByBillingUserID(id int) *FilterUsers {
+	f.addInt("filter[billing_user_id]", id)
+	return f
+}
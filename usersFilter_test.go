@@ -9,9 +9,16 @@ import (
 func TestFilterUsers(t *testing.T) {
 	f := FilterUsers{}
 
-	f.ByStatus("status")
+	f.
+		ByStatus("status").
+		ByEmail("user@example.com").
+		ByRoleID(1).
+		ByBillingUserID(2)
 
 	require.Equal(t, map[string]string{
-		"filter[status]": "status",
+		"filter[status]":          "status",
+		"filter[search]":          "user@example.com",
+		"filter[role]":            "1",
+		"filter[billing_user_id]": "2",
 	}, f.data)
 }
@@ -0,0 +1,48 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// UserLoginLink represents a one-time link for logging in on behalf of a user.
+type UserLoginLink struct {
+	URL string `json:"url"`
+	// ExpiresAt for date in RFC3339Nano format
+	ExpiresAt string `json:"expires_at"`
+}
+
+// IssueAPIToken issues a new API token on behalf of the specified user.
+// The token may be used for authenticating as the user, e.g. with
+// APITokenAuthenticator.
+func (s *UsersService) IssueAPIToken(ctx context.Context, id int, name string) (APIToken, error) {
+	var resp struct {
+		Data APIToken `json:"data"`
+	}
+	return resp.Data, s.client.create(
+		ctx,
+		fmt.Sprintf("users/%d/api_tokens", id),
+		APITokenCreateRequest{Name: name},
+		&resp,
+	)
+}
+
+// LoginLink creates a one-time link which logs in to the user interface on
+// behalf of the specified user.
+func (s *UsersService) LoginLink(ctx context.Context, id int) (UserLoginLink, error) {
+	path := fmt.Sprintf("users/%d/login_link", id)
+	body, code, err := s.client.request(ctx, http.MethodPost, path)
+	if err != nil {
+		return UserLoginLink{}, err
+	}
+
+	if code != http.StatusOK {
+		return UserLoginLink{}, newHTTPError(http.MethodPost, path, code, body)
+	}
+
+	var resp struct {
+		Data UserLoginLink `json:"data"`
+	}
+	return resp.Data, unmarshal(body, &resp)
+}
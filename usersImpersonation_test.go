@@ -0,0 +1,70 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsersService_IssueAPIToken(t *testing.T) {
+	expected := APIToken{
+		ID:          1,
+		Name:        "billing",
+		AccessToken: "token",
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/10/api_tokens", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, APITokenCreateRequest{Name: "billing"})
+
+		writeResponse(t, w, http.StatusCreated, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Users.IssueAPIToken(context.Background(), 10, "billing")
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestUsersService_LoginLink(t *testing.T) {
+	expected := UserLoginLink{
+		URL:       "https://example.com/login/token",
+		ExpiresAt: "1970-01-01T00:00:00.000000Z",
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/users/10/login_link", r.URL.Path)
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			writeResponse(t, w, http.StatusOK, expected)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Users.LoginLink(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("failed to make request", func(t *testing.T) {
+			asserter, addr := startBrokenTestServer(t)
+			_, err := createTestClient(t, addr).Users.LoginLink(context.Background(), 10)
+			asserter(t, http.MethodPost, "/users/10/login_link", err)
+		})
+
+		t.Run("invalid status code", func(t *testing.T) {
+			s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			defer s.Close()
+
+			_, err := createTestClient(t, s.URL).Users.LoginLink(context.Background(), 10)
+			assert.EqualError(t, err, "HTTP POST users/10/login_link returns 400 status code")
+		})
+	})
+}
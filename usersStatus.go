@@ -0,0 +1,75 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+)
+
+// UserStatusChange represents a result of changing user's status.
+type UserStatusChange struct {
+	User User
+
+	// Servers results of suspending or resuming the user's servers.
+	Servers map[int]BatchResult
+}
+
+// ChangeStatus changes status of the specified user and cascades it to the
+// user's virtual servers. Servers of locked or suspended user are suspended,
+// suspended servers of active user are resumed.
+// The options are used for performing the action on the servers.
+func (s *UsersService) ChangeStatus(
+	ctx context.Context,
+	id int,
+	status UserStatus,
+	opts BatchOptions,
+) (UserStatusChange, error) {
+	user, err := s.Update(ctx, id, UserUpdateRequest{Status: string(status)})
+	if err != nil {
+		return UserStatusChange{}, err
+	}
+
+	res := UserStatusChange{User: user}
+
+	action := BatchActionSuspend
+	if status == UserStatusActive {
+		action = BatchActionResume
+	}
+
+	servers, err := s.client.VirtualServers.List(ctx, (&FilterVirtualServers{}).ByUserID(id))
+	if err != nil {
+		return res, fmt.Errorf("list servers of user %d: %w", id, err)
+	}
+
+	var ids []int
+	for {
+		for _, vs := range servers.Data {
+			if vs.IsSuspended == (action == BatchActionResume) {
+				ids = append(ids, vs.ID)
+			}
+		}
+		if !servers.Next(ctx) {
+			break
+		}
+	}
+	if servers.Err() != nil {
+		return res, fmt.Errorf("list servers of user %d: %w", id, servers.Err())
+	}
+
+	res.Servers, err = s.client.VirtualServers.Batch(ctx, ids, action, opts)
+	return res, err
+}
+
+// Activate activates specified user and resumes the user's servers.
+func (s *UsersService) Activate(ctx context.Context, id int) (UserStatusChange, error) {
+	return s.ChangeStatus(ctx, id, UserStatusActive, BatchOptions{})
+}
+
+// Lock locks specified user and suspends the user's servers.
+func (s *UsersService) Lock(ctx context.Context, id int) (UserStatusChange, error) {
+	return s.ChangeStatus(ctx, id, UserStatusLocked, BatchOptions{})
+}
+
+// Suspend suspends specified user and the user's servers.
+func (s *UsersService) Suspend(ctx context.Context, id int) (UserStatusChange, error) {
+	return s.ChangeStatus(ctx, id, UserStatusSuspended, BatchOptions{})
+}
@@ -0,0 +1,98 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func usersStatusTestHandler(t *testing.T, status UserStatus) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/users/10":
+			assertRequestBody(t, r, UserUpdateRequest{Status: string(status)})
+			writeResponse(t, w, http.StatusOK, User{ID: 10, Status: status})
+
+		case r.Method == http.MethodGet && r.URL.Path == "/servers":
+			assertRequestQuery(t, r, url.Values{
+				"filter[user_id]": []string{"10"},
+			})
+			writeJSON(t, w, http.StatusOK, VirtualServersResponse{
+				Data: []VirtualServer{
+					{ID: 1},
+					{ID: 2, IsSuspended: true},
+				},
+			})
+
+		case r.Method == http.MethodPost && r.URL.Path == "/servers/1/suspend":
+			writeResponse(t, w, http.StatusOK, Task{ID: 100})
+
+		case r.Method == http.MethodPost && r.URL.Path == "/servers/2/resume":
+			writeResponse(t, w, http.StatusOK, Task{ID: 200})
+
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestUsersService_ChangeStatus(t *testing.T) {
+	t.Run("suspend", func(t *testing.T) {
+		s := startTestServer(t, usersStatusTestHandler(t, UserStatusSuspended))
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Users.Suspend(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, UserStatusChange{
+			User:    User{ID: 10, Status: UserStatusSuspended},
+			Servers: map[int]BatchResult{1: {Task: Task{ID: 100}}},
+		}, actual)
+	})
+
+	t.Run("lock", func(t *testing.T) {
+		s := startTestServer(t, usersStatusTestHandler(t, UserStatusLocked))
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Users.Lock(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, UserStatusChange{
+			User:    User{ID: 10, Status: UserStatusLocked},
+			Servers: map[int]BatchResult{1: {Task: Task{ID: 100}}},
+		}, actual)
+	})
+
+	t.Run("activate", func(t *testing.T) {
+		s := startTestServer(t, usersStatusTestHandler(t, UserStatusActive))
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Users.Activate(context.Background(), 10)
+		require.NoError(t, err)
+		require.Equal(t, UserStatusChange{
+			User:    User{ID: 10, Status: UserStatusActive},
+			Servers: map[int]BatchResult{2: {Task: Task{ID: 200}}},
+		}, actual)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				writeResponse(t, w, http.StatusOK, User{ID: 10})
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).Users.ChangeStatus(
+			context.Background(),
+			10,
+			UserStatusSuspended,
+			BatchOptions{},
+		)
+		require.EqualError(t, err, "list servers of user 10: HTTP GET servers returns 400 status code")
+		require.Equal(t, User{ID: 10}, actual.User)
+	})
+}
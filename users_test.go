@@ -89,3 +89,17 @@ func TestUsersService_Delete(t *testing.T) {
 	err := createTestClient(t, s.URL).Users.Delete(context.Background(), 10)
 	require.NoError(t, err)
 }
+
+func TestUsersService_Get(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/10", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeUser)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).Users.Get(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeUser, actual)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 )
 
 // VirtualServersService handles all available methods with virtual servers.
@@ -125,7 +126,22 @@ type VirtualServerSpecifications struct {
 
 // VirtualServerUsage represent virtual server usage.
 type VirtualServerUsage struct {
-	CPU float64 `json:"cpu"`
+	CPU     float64                   `json:"cpu"`
+	Network VirtualServerNetworkUsage `json:"network"`
+}
+
+// VirtualServerNetworkUsage represent virtual server network traffic consumed
+// since the last limits reset.
+type VirtualServerNetworkUsage struct {
+	Incoming VirtualServerTrafficUsage `json:"incoming"`
+	Outgoing VirtualServerTrafficUsage `json:"outgoing"`
+}
+
+// VirtualServerTrafficUsage represent single direction network traffic usage.
+type VirtualServerTrafficUsage struct {
+	// Value a consumed traffic in bytes.
+	Value      int64 `json:"value"`
+	IsExceeded bool  `json:"is_exceeded"`
 }
 
 type VirtualServerCreateRequest struct {
@@ -288,6 +304,16 @@ func (s *VirtualServersService) Restart(ctx context.Context, id int) (Task, erro
 	return s.client.asyncPost(ctx, fmt.Sprintf("servers/%d/restart", id))
 }
 
+// Suspend suspends specified virtual server.
+func (s *VirtualServersService) Suspend(ctx context.Context, id int) (Task, error) {
+	return s.client.asyncPost(ctx, fmt.Sprintf("servers/%d/suspend", id))
+}
+
+// Resume resumes specified suspended virtual server.
+func (s *VirtualServersService) Resume(ctx context.Context, id int) (Task, error) {
+	return s.client.asyncPost(ctx, fmt.Sprintf("servers/%d/resume", id))
+}
+
 // Backup backing up specified virtual server.
 func (s *VirtualServersService) Backup(ctx context.Context, id int) (Backup, error) {
 	path := fmt.Sprintf("servers/%d/backups", id)
@@ -304,6 +330,25 @@ func (s *VirtualServersService) Backup(ctx context.Context, id int) (Backup, err
 	return resp.Data, unmarshal(body, &resp)
 }
 
+// Backups lists backups of the specified virtual server.
+func (s *VirtualServersService) Backups(ctx context.Context, id int) (BackupsResponse, error) {
+	resp := BackupsResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, fmt.Sprintf("servers/%d/backups", id), &resp)
+}
+
+// BackupSettingsUpdate updates backup schedule of the specified virtual server.
+func (s *VirtualServersService) BackupSettingsUpdate(
+	ctx context.Context,
+	id int,
+	data VirtualServerBackupSettings,
+) (VirtualServer, error) {
+	return s.Patch(ctx, id, VirtualServerUpdateRequest{BackupSettings: &data})
+}
+
 type VirtualServerResizeRequest struct {
 	PreserveDisk   bool                         `json:"preserve_disk"`
 	PlanID         int                          `json:"plan_id"`
@@ -326,6 +371,72 @@ func (s *VirtualServersService) SnapshotsCreate(ctx context.Context, vmID int, d
 	return resp.Data, s.client.create(ctx, fmt.Sprintf("servers/%d/snapshots", vmID), data, &resp)
 }
 
+// Snapshots lists snapshots of the specified virtual server.
+func (s *VirtualServersService) Snapshots(ctx context.Context, id int) (SnapshotsResponse, error) {
+	resp := SnapshotsResponse{
+		paginatedResponse: paginatedResponse{
+			service: (*service)(s),
+		},
+	}
+	return resp, s.client.list(ctx, fmt.Sprintf("servers/%d/snapshots", id), &resp)
+}
+
+// SnapshotsRetain keeps the specified number of the newest available snapshots
+// of the virtual server and deletes the rest of them. Failed snapshots are
+// always deleted and don't count toward the kept ones. Snapshots which are
+// still processing are never deleted.
+// Returns the delete tasks which were started before an error occurred, if any.
+func (s *VirtualServersService) SnapshotsRetain(ctx context.Context, id int, keep int) ([]Task, error) {
+	resp, err := s.Snapshots(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []Snapshot
+	for {
+		snapshots = append(snapshots, resp.Data...)
+		if !resp.Next(ctx) {
+			break
+		}
+	}
+	if resp.Err() != nil {
+		return nil, resp.Err()
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if snapshots[i].CreatedAt != snapshots[j].CreatedAt {
+			return snapshots[i].CreatedAt > snapshots[j].CreatedAt
+		}
+		return snapshots[i].ID > snapshots[j].ID
+	})
+
+	if keep < 0 {
+		keep = 0
+	}
+
+	var tasks []Task
+	kept := 0
+	for _, snapshot := range snapshots {
+		switch snapshot.Status {
+		case SnapshotStatusProcessing:
+			continue
+
+		case SnapshotStatusAvailable:
+			if kept < keep {
+				kept++
+				continue
+			}
+		}
+
+		task, err := s.client.Snapshots.Delete(ctx, snapshot.ID)
+		if err != nil {
+			return tasks, fmt.Errorf("delete snapshot %d: %w", snapshot.ID, err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
 // Disks gets a list of disks for the specified virtual server.
 func (s *VirtualServersService) Disks(ctx context.Context, id int) ([]Disk, error) {
 	var resp disksResponse
@@ -0,0 +1,148 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// BatchAction represents available actions which can be performed on many
+// virtual servers at once.
+type BatchAction string
+
+const (
+	// BatchActionStart starts virtual servers.
+	BatchActionStart BatchAction = "start"
+
+	// BatchActionStop stops virtual servers.
+	BatchActionStop BatchAction = "stop"
+
+	// BatchActionRestart restarts virtual servers.
+	BatchActionRestart BatchAction = "restart"
+
+	// BatchActionDelete deletes virtual servers.
+	BatchActionDelete BatchAction = "delete"
+
+	// BatchActionSuspend suspends virtual servers.
+	BatchActionSuspend BatchAction = "suspend"
+
+	// BatchActionResume resumes suspended virtual servers.
+	BatchActionResume BatchAction = "resume"
+)
+
+// BatchOptions represents available options for performing batch actions.
+type BatchOptions struct {
+	// Concurrency a maximum number of virtual servers processed simultaneously.
+	// All servers are processed one by one if it's less than 1.
+	Concurrency int
+
+	// Wait indicates we should wait until the tasks started by the action
+	// are finished.
+	Wait bool
+
+	// StopOnFailure indicates we shouldn't process the rest of the servers after
+	// the first failure. Otherwise, all servers are processed regardless of
+	// failures.
+	StopOnFailure bool
+}
+
+// BatchResult represents a result of batch action for a single virtual server.
+type BatchResult struct {
+	Task Task
+	Err  error
+}
+
+// Batch performs the action on all specified virtual servers.
+// Returns a result per processed server. In StopOnFailure mode the servers
+// which weren't processed due to a failure are absent in the result, and the
+// first occurred error is returned. The servers which were already being
+// processed at the moment of the failure are processed completely, including
+// waiting for their tasks, so their results are always present.
+// If the context is done before all servers are processed, the servers which
+// weren't processed are absent in the result as well, and the context's error
+// is returned.
+func (s *VirtualServersService) Batch(
+	ctx context.Context,
+	ids []int,
+	action BatchAction,
+	opts BatchOptions,
+) (map[int]BatchResult, error) {
+	fn, err := s.batchActionFunc(action)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// stop cancels dispatching of the rest of the servers on the first failure,
+	// the servers which are already being processed use the parent context.
+	stop, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		results  = make(map[int]BatchResult, len(ids))
+		sem      = make(chan struct{}, concurrency)
+		started  int
+	)
+
+	for _, id := range ids {
+		select {
+		case sem <- struct{}{}:
+		case <-stop.Done():
+		}
+
+		if stop.Err() != nil {
+			break
+		}
+		started++
+
+		wg.Add(1)
+		go func(id int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			task, err := fn(ctx, id)
+			if err == nil && opts.Wait {
+				task, err = s.client.Tasks.Wait(ctx, task.ID)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			results[id] = BatchResult{Task: task, Err: err}
+			if err != nil && opts.StopOnFailure && firstErr == nil {
+				firstErr = fmt.Errorf("server %d: %w", id, err)
+				cancel()
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	if firstErr == nil && started < len(ids) {
+		return results, ctx.Err()
+	}
+	return results, firstErr
+}
+
+func (s *VirtualServersService) batchActionFunc(action BatchAction) (func(context.Context, int) (Task, error), error) {
+	fn, ok := map[BatchAction]func(context.Context, int) (Task, error){
+		BatchActionStart:   s.Start,
+		BatchActionStop:    s.Stop,
+		BatchActionRestart: s.Restart,
+		BatchActionDelete:  s.Delete,
+		BatchActionSuspend: s.Suspend,
+		BatchActionResume:  s.Resume,
+	}[action]
+	if !ok {
+		return nil, fmt.Errorf("unsupported batch action %q", action)
+	}
+	return fn, nil
+}
@@ -0,0 +1,190 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualServersService_Batch(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		var inFlight, maxInFlight int32
+
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/restart"):
+				n := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					m := atomic.LoadInt32(&maxInFlight)
+					if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+						break
+					}
+				}
+
+				id, err := strconv.Atoi(strings.Split(r.URL.Path, "/")[2])
+				require.NoError(t, err)
+				writeResponse(t, w, http.StatusOK, Task{ID: id * 10, Status: TaskStatusPending})
+
+			case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/tasks/"):
+				id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/tasks/"))
+				require.NoError(t, err)
+				writeResponse(t, w, http.StatusOK, Task{ID: id, Status: TaskStatusDone})
+
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).VirtualServers.Batch(
+			context.Background(),
+			[]int{1, 2, 3, 4, 5},
+			BatchActionRestart,
+			BatchOptions{Concurrency: 2, Wait: true},
+		)
+		require.NoError(t, err)
+		require.Equal(t, map[int]BatchResult{
+			1: {Task: Task{ID: 10, Status: TaskStatusDone}},
+			2: {Task: Task{ID: 20, Status: TaskStatusDone}},
+			3: {Task: Task{ID: 30, Status: TaskStatusDone}},
+			4: {Task: Task{ID: 40, Status: TaskStatusDone}},
+			5: {Task: Task{ID: 50, Status: TaskStatusDone}},
+		}, actual)
+		require.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+	})
+
+	t.Run("best effort", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+
+			if r.URL.Path == "/servers/2" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeResponse(t, w, http.StatusOK, fakeTask)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).VirtualServers.Batch(
+			context.Background(),
+			[]int{1, 2, 3},
+			BatchActionDelete,
+			BatchOptions{Concurrency: 3},
+		)
+		require.NoError(t, err)
+		require.Len(t, actual, 3)
+		require.Equal(t, fakeTask, actual[1].Task)
+		require.EqualError(t, actual[2].Err, "HTTP DELETE servers/2 returns 404 status code")
+		require.Equal(t, fakeTask, actual[3].Task)
+	})
+
+	t.Run("stop on failure", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			if r.URL.Path == "/servers/2/stop" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			writeResponse(t, w, http.StatusOK, fakeTask)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).VirtualServers.Batch(
+			context.Background(),
+			[]int{1, 2, 3, 4},
+			BatchActionStop,
+			BatchOptions{StopOnFailure: true},
+		)
+		require.EqualError(t, err, "server 2: HTTP POST servers/2/stop returns 400 status code")
+		require.Equal(t, map[int]BatchResult{
+			1: {Task: fakeTask},
+			2: {Err: actual[2].Err},
+		}, actual)
+	})
+
+	t.Run("stop on failure waits for started tasks", func(t *testing.T) {
+		failed := make(chan struct{})
+
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/servers/1/start":
+				writeResponse(t, w, http.StatusOK, Task{ID: 10, Status: TaskStatusPending})
+
+			case "/servers/2/start":
+				w.WriteHeader(http.StatusBadRequest)
+				close(failed)
+
+			case "/tasks/10":
+				// The task is finished only after the other server failed, so
+				// the wait is in progress at the moment of the failure.
+				select {
+				case <-failed:
+					writeResponse(t, w, http.StatusOK, Task{ID: 10, Status: TaskStatusDone})
+				default:
+					writeResponse(t, w, http.StatusOK, Task{ID: 10, Status: TaskStatusRunning})
+				}
+
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).VirtualServers.Batch(
+			context.Background(),
+			[]int{1, 2, 3},
+			BatchActionStart,
+			BatchOptions{Concurrency: 2, Wait: true, StopOnFailure: true},
+		)
+		require.EqualError(t, err, "server 2: HTTP POST servers/2/start returns 400 status code")
+		require.Equal(t, map[int]BatchResult{
+			1: {Task: Task{ID: 10, Status: TaskStatusDone}},
+			2: {Err: actual[2].Err},
+		}, actual)
+	})
+
+	t.Run("context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			if r.URL.Path == "/servers/2/resume" {
+				cancel()
+			}
+			writeResponse(t, w, http.StatusOK, fakeTask)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).VirtualServers.Batch(
+			ctx,
+			[]int{1, 2, 3, 4},
+			BatchActionResume,
+			BatchOptions{},
+		)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, fakeTask, actual[1].Task)
+		require.NotContains(t, actual, 3)
+		require.NotContains(t, actual, 4)
+	})
+
+	t.Run("unsupported action", func(t *testing.T) {
+		_, err := createTestClient(t, "http://example.com").VirtualServers.Batch(
+			context.Background(),
+			[]int{1},
+			"foo",
+			BatchOptions{},
+		)
+		require.EqualError(t, err, fmt.Sprintf("unsupported batch action %q", "foo"))
+	})
+}
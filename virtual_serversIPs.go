@@ -0,0 +1,42 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+)
+
+// VirtualServerIPAddressRequest represents available properties for attaching
+// an additional IP address to a virtual server.
+type VirtualServerIPAddressRequest struct {
+	Type IPVersion `json:"type"`
+
+	// IPBlockID an IP block from which address should be allocated. The IP block
+	// will be chosen automatically if it's omitted.
+	IPBlockID int `json:"ip_block_id,omitempty"`
+
+	// IP a specific IP address which should be attached. The next free address
+	// from the IP block will be used if it's omitted.
+	IP string `json:"ip,omitempty"`
+}
+
+// IPAddressAttach attaches an additional IP address to the specified virtual
+// server.
+func (s *VirtualServersService) IPAddressAttach(
+	ctx context.Context,
+	id int,
+	data VirtualServerIPAddressRequest,
+) (Task, error) {
+	return s.client.asyncPost(ctx, fmt.Sprintf("servers/%d/ips", id), withBody(data))
+}
+
+// IPAddressDetach detaches specified IP address from the virtual server.
+// The primary IP address can't be detached.
+func (s *VirtualServersService) IPAddressDetach(ctx context.Context, id, ipID int) (Task, error) {
+	return s.client.asyncDelete(ctx, fmt.Sprintf("servers/%d/ips/%d", id, ipID))
+}
+
+// IPAddressSetPrimary makes specified IP address the virtual server's primary
+// IP address.
+func (s *VirtualServersService) IPAddressSetPrimary(ctx context.Context, id, ipID int) (Task, error) {
+	return s.client.asyncPost(ctx, fmt.Sprintf("servers/%d/ips/%d/primary", id, ipID))
+}
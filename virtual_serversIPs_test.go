@@ -0,0 +1,59 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualServersService_IPAddressAttach(t *testing.T) {
+	data := VirtualServerIPAddressRequest{
+		Type:      IPv4,
+		IPBlockID: 2,
+		IP:        "192.0.2.3",
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers/10/ips", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assertRequestBody(t, r, data)
+
+		writeResponse(t, w, http.StatusOK, fakeTask)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).VirtualServers.IPAddressAttach(context.Background(), 10, data)
+	require.NoError(t, err)
+	require.Equal(t, fakeTask, actual)
+}
+
+func TestVirtualServersService_IPAddressDetach(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers/10/ips/20", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeTask)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).VirtualServers.IPAddressDetach(context.Background(), 10, 20)
+	require.NoError(t, err)
+	require.Equal(t, fakeTask, actual)
+}
+
+func TestVirtualServersService_IPAddressSetPrimary(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers/10/ips/20/primary", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeTask)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).VirtualServers.IPAddressSetPrimary(context.Background(), 10, 20)
+	require.NoError(t, err)
+	require.Equal(t, fakeTask, actual)
+}
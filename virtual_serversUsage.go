@@ -0,0 +1,94 @@
+package solus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// VirtualServerMetrics represents virtual server's historic metrics.
+// Each field is a time series ordered by time.
+type VirtualServerMetrics struct {
+	// CPU a CPU usage in percents.
+	CPU []MetricPoint `json:"cpu"`
+
+	// Memory a used memory in bytes.
+	Memory []MetricPoint `json:"memory"`
+
+	// DiskRead a disk read rate in bytes per second.
+	DiskRead []MetricPoint `json:"disk_read"`
+
+	// DiskWrite a disk write rate in bytes per second.
+	DiskWrite []MetricPoint `json:"disk_write"`
+
+	// NetworkIncoming an incoming network traffic in bytes.
+	NetworkIncoming []MetricPoint `json:"network_incoming"`
+
+	// NetworkOutgoing an outgoing network traffic in bytes.
+	NetworkOutgoing []MetricPoint `json:"network_outgoing"`
+}
+
+// MetricPoint represents a single value of a metric time series.
+type MetricPoint struct {
+	// Time for date in RFC3339 format.
+	Time  string  `json:"time"`
+	Value float64 `json:"value"`
+}
+
+// VirtualServerTrafficLimitsUsage represents how many percents of plan's
+// traffic limits are consumed by a virtual server.
+// Percents may be greater than 100 if a limit is exceeded. Disabled limits
+// are always reported as 0.
+type VirtualServerTrafficLimitsUsage struct {
+	Incoming float64
+	Outgoing float64
+	Total    float64
+}
+
+// LimitsUsage computes consumed percents of specified plan's traffic limits.
+func (u VirtualServerNetworkUsage) LimitsUsage(l PlanLimits) VirtualServerTrafficLimitsUsage {
+	return VirtualServerTrafficLimitsUsage{
+		Incoming: l.NetworkIncomingTraffic.Percent(u.Incoming.Value),
+		Outgoing: l.NetworkOutgoingTraffic.Percent(u.Outgoing.Value),
+		Total:    l.NetworkTotalTraffic.Percent(u.Incoming.Value + u.Outgoing.Value),
+	}
+}
+
+// TrafficLimitsUsage computes consumed percents of the virtual server's plan
+// traffic limits.
+func (vs VirtualServer) TrafficLimitsUsage() VirtualServerTrafficLimitsUsage {
+	return vs.Usage.Network.LimitsUsage(vs.Plan.Limits)
+}
+
+// Usage gets specified virtual server's current resources usage.
+func (s *VirtualServersService) Usage(ctx context.Context, id int) (VirtualServerUsage, error) {
+	var resp struct {
+		Data VirtualServerUsage `json:"data"`
+	}
+	return resp.Data, s.client.get(ctx, fmt.Sprintf("servers/%d/usage", id), &resp)
+}
+
+// Metrics gets specified virtual server's metrics for the time period between
+// from and to. The resolution is a time interval between metric points, it will
+// be chosen by the API if zero is passed.
+func (s *VirtualServersService) Metrics(
+	ctx context.Context,
+	id int,
+	from time.Time,
+	to time.Time,
+	resolution time.Duration,
+) (VirtualServerMetrics, error) {
+	params := map[string]string{
+		"from": from.UTC().Format(time.RFC3339),
+		"to":   to.UTC().Format(time.RFC3339),
+	}
+	if resolution > 0 {
+		params["resolution"] = strconv.Itoa(int(resolution.Seconds()))
+	}
+
+	var resp struct {
+		Data VirtualServerMetrics `json:"data"`
+	}
+	return resp.Data, s.client.list(ctx, fmt.Sprintf("servers/%d/metrics", id), &resp, withFilter(params))
+}
@@ -0,0 +1,124 @@
+package solus
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualServerNetworkUsage_LimitsUsage(t *testing.T) {
+	u := VirtualServerNetworkUsage{
+		Incoming: VirtualServerTrafficUsage{Value: 1 << 30},
+		Outgoing: VirtualServerTrafficUsage{Value: 3 << 30},
+	}
+
+	vs := VirtualServer{
+		Usage: VirtualServerUsage{Network: u},
+		Plan: Plan{
+			Limits: PlanLimits{
+				NetworkIncomingTraffic: TrafficPlanLimit{
+					IsEnabled: true,
+					Limit:     4,
+					Unit:      TrafficPlanLimitUnitGiB,
+				},
+				NetworkOutgoingTraffic: TrafficPlanLimit{
+					Limit: 4,
+					Unit:  TrafficPlanLimitUnitGiB,
+				},
+				NetworkTotalTraffic: TrafficPlanLimit{
+					IsEnabled: true,
+					Limit:     2,
+					Unit:      TrafficPlanLimitUnitGiB,
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, VirtualServerTrafficLimitsUsage{
+		Incoming: 25,
+		Outgoing: 0,
+		Total:    200,
+	}, vs.TrafficLimitsUsage())
+}
+
+func TestVirtualServersService_Usage(t *testing.T) {
+	expected := VirtualServerUsage{
+		CPU: 42,
+		Network: VirtualServerNetworkUsage{
+			Incoming: VirtualServerTrafficUsage{Value: 1024},
+			Outgoing: VirtualServerTrafficUsage{Value: 2048, IsExceeded: true},
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers/10/usage", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeResponse(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).VirtualServers.Usage(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestVirtualServersService_Metrics(t *testing.T) {
+	expected := VirtualServerMetrics{
+		CPU:             []MetricPoint{{Time: "2021-01-01T00:00:00Z", Value: 1}},
+		Memory:          []MetricPoint{{Time: "2021-01-01T00:00:00Z", Value: 2}},
+		DiskRead:        []MetricPoint{{Time: "2021-01-01T00:00:00Z", Value: 3}},
+		DiskWrite:       []MetricPoint{{Time: "2021-01-01T00:00:00Z", Value: 4}},
+		NetworkIncoming: []MetricPoint{{Time: "2021-01-01T00:00:00Z", Value: 5}},
+		NetworkOutgoing: []MetricPoint{{Time: "2021-01-01T00:00:00Z", Value: 6}},
+	}
+
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	t.Run("with resolution", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/servers/10/metrics", r.URL.Path)
+			assert.Equal(t, http.MethodGet, r.Method)
+			assertRequestQuery(t, r, url.Values{
+				"from":       []string{"2021-01-01T00:00:00Z"},
+				"to":         []string{"2021-01-01T01:00:00Z"},
+				"resolution": []string{"300"},
+			})
+
+			writeResponse(t, w, http.StatusOK, expected)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).VirtualServers.Metrics(
+			context.Background(),
+			10,
+			from,
+			to,
+			5*time.Minute,
+		)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("without resolution", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assertRequestQuery(t, r, url.Values{
+				"from": []string{"2021-01-01T00:00:00Z"},
+				"to":   []string{"2021-01-01T01:00:00Z"},
+			})
+
+			writeResponse(t, w, http.StatusOK, expected)
+		})
+		defer s.Close()
+
+		actual, err := createTestClient(t, s.URL).VirtualServers.Metrics(context.Background(), 10, from, to, 0)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+}
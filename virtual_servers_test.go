@@ -195,6 +195,34 @@ func TestVirtualServersService_Restart(t *testing.T) {
 	require.Equal(t, fakeTask, actual)
 }
 
+func TestVirtualServersService_Suspend(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers/10/suspend", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeTask)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).VirtualServers.Suspend(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeTask, actual)
+}
+
+func TestVirtualServersService_Resume(t *testing.T) {
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers/10/resume", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		writeResponse(t, w, http.StatusOK, fakeTask)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).VirtualServers.Resume(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, fakeTask, actual)
+}
+
 func TestVirtualServersService_Backup(t *testing.T) {
 	t.Run("positive", func(t *testing.T) {
 		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -232,6 +260,54 @@ func TestVirtualServersService_Backup(t *testing.T) {
 	})
 }
 
+func TestVirtualServersService_Backups(t *testing.T) {
+	expected := BackupsResponse{
+		Data: []Backup{
+			fakeBackup,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers/10/backups", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).VirtualServers.Backups(context.Background(), 10)
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
+func TestVirtualServersService_BackupSettingsUpdate(t *testing.T) {
+	data := VirtualServerBackupSettings{
+		Enabled: true,
+		Schedule: VirtualServerBackupSettingsSchedule{
+			Type: ServerBackupSettingsScheduleTypeWeekly,
+			Time: VirtualServerBackupSettingsScheduleTime{
+				Hour:    1,
+				Minutes: 2,
+			},
+			Days: []int{3},
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers/10", r.URL.Path)
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assertRequestBody(t, r, VirtualServerUpdateRequest{BackupSettings: &data})
+
+		writeResponse(t, w, http.StatusOK, fakeVirtualServer)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).VirtualServers.BackupSettingsUpdate(context.Background(), 10, data)
+	require.NoError(t, err)
+	require.Equal(t, fakeVirtualServer, actual)
+}
+
 func TestVirtualServersService_resize(t *testing.T) {
 	data := VirtualServerResizeRequest{
 		PreserveDisk: true,
@@ -287,3 +363,92 @@ func TestVirtualServersService_SnapshotsCreate(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, fakeSnapshot, actual)
 }
+
+func TestVirtualServersService_Snapshots(t *testing.T) {
+	expected := SnapshotsResponse{
+		Data: []Snapshot{
+			fakeSnapshot,
+		},
+	}
+
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/servers/10/snapshots", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		writeJSON(t, w, http.StatusOK, expected)
+	})
+	defer s.Close()
+
+	actual, err := createTestClient(t, s.URL).VirtualServers.Snapshots(context.Background(), 10)
+	require.NoError(t, err)
+	actual.service = nil
+	require.Equal(t, expected, actual)
+}
+
+func TestVirtualServersService_SnapshotsRetain(t *testing.T) {
+	snapshots := []Snapshot{
+		{ID: 1, Status: SnapshotStatusAvailable, CreatedAt: "2021-01-01T00:00:00Z"},
+		{ID: 2, Status: SnapshotStatusAvailable, CreatedAt: "2021-01-03T00:00:00Z"},
+		{ID: 3, Status: SnapshotStatusProcessing, CreatedAt: "2021-01-02T00:00:00Z"},
+		{ID: 4, Status: SnapshotStatusFailed, CreatedAt: "2021-01-04T00:00:00Z"},
+		{ID: 5, Status: SnapshotStatusAvailable, CreatedAt: "2021-01-02T00:00:00Z"},
+	}
+
+	var deleted []string
+	s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "/servers/10/snapshots", r.URL.Path)
+			writeJSON(t, w, http.StatusOK, SnapshotsResponse{Data: snapshots})
+
+		case http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			writeResponse(t, w, http.StatusOK, fakeTask)
+
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	defer s.Close()
+
+	t.Run("positive", func(t *testing.T) {
+		deleted = nil
+
+		tasks, err := createTestClient(t, s.URL).VirtualServers.SnapshotsRetain(context.Background(), 10, 2)
+		require.NoError(t, err)
+		require.Equal(t, []Task{fakeTask, fakeTask}, tasks)
+		require.Equal(t, []string{"/snapshots/4", "/snapshots/1"}, deleted)
+	})
+
+	t.Run("keep all available", func(t *testing.T) {
+		deleted = nil
+
+		tasks, err := createTestClient(t, s.URL).VirtualServers.SnapshotsRetain(context.Background(), 10, 10)
+		require.NoError(t, err)
+		require.Equal(t, []Task{fakeTask}, tasks)
+		require.Equal(t, []string{"/snapshots/4"}, deleted)
+	})
+
+	t.Run("failed snapshots don't count", func(t *testing.T) {
+		deleted = nil
+
+		tasks, err := createTestClient(t, s.URL).VirtualServers.SnapshotsRetain(context.Background(), 10, 1)
+		require.NoError(t, err)
+		require.Len(t, tasks, 3)
+		require.Equal(t, []string{"/snapshots/4", "/snapshots/5", "/snapshots/1"}, deleted)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		s := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				writeJSON(t, w, http.StatusOK, SnapshotsResponse{Data: snapshots})
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		defer s.Close()
+
+		_, err := createTestClient(t, s.URL).VirtualServers.SnapshotsRetain(context.Background(), 10, 0)
+		require.EqualError(t, err, "delete snapshot 4: HTTP DELETE snapshots/4 returns 400 status code")
+	})
+}
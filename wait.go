@@ -0,0 +1,43 @@
+package solus
+
+import (
+	"context"
+	"time"
+)
+
+// pollFunc represents functions which are called by poll until they return
+// done or an error.
+type pollFunc func(ctx context.Context) (done bool, err error)
+
+// pollInterval returns Client.PollInterval, or the default interval if it
+// isn't positive, so requests are never made in a tight loop.
+func (c *Client) pollInterval() time.Duration {
+	if c.PollInterval <= 0 {
+		return defaultPollInterval
+	}
+	return c.PollInterval
+}
+
+// poll calls fn with Client.PollInterval delay between calls until fn reports
+// it's done, returns an error or the context is done. The default interval is
+// used if Client.PollInterval isn't positive.
+func (c *Client) poll(ctx context.Context, fn pollFunc) error {
+	for {
+		done, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		t := time.NewTimer(c.pollInterval())
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
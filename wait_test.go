@@ -0,0 +1,56 @@
+package solus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_poll(t *testing.T) {
+	c := &Client{PollInterval: time.Millisecond}
+
+	t.Run("positive", func(t *testing.T) {
+		calls := 0
+		err := c.poll(context.Background(), func(context.Context) (bool, error) {
+			calls++
+			return calls == 3, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("invalid poll interval", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		calls := 0
+		err := (&Client{PollInterval: 0}).poll(ctx, func(context.Context) (bool, error) {
+			calls++
+			return false, nil
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("fn failed", func(t *testing.T) {
+			err := c.poll(context.Background(), func(context.Context) (bool, error) {
+				return false, errors.New("fake error")
+			})
+			require.EqualError(t, err, "fake error")
+		})
+
+		t.Run("context canceled", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			err := c.poll(ctx, func(context.Context) (bool, error) {
+				cancel()
+				return false, nil
+			})
+			require.Equal(t, context.Canceled, err)
+		})
+	})
+}